@@ -0,0 +1,113 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	immediatesubmitter "github.com/wealdtech/probec/services/submitter/immediate"
+	"github.com/wealdtech/probec/util"
+)
+
+// collectorConfig is the configuration for a single collector.
+type collectorConfig struct {
+	BaseURL      string        `mapstructure:"base-url"`
+	BearerToken  string        `mapstructure:"bearer-token"`
+	APIKey       string        `mapstructure:"api-key"`
+	APIKeyHeader string        `mapstructure:"api-key-header"`
+	CACert       string        `mapstructure:"ca-cert"`
+	ClientCert   string        `mapstructure:"client-cert"`
+	ClientKey    string        `mapstructure:"client-key"`
+	Timeout      time.Duration `mapstructure:"timeout"`
+	Proxy        string        `mapstructure:"proxy"`
+}
+
+// obtainCollectors obtains the base URLs of the collectors to which the
+// submitter at the path submits, along with any per-collector configuration.
+func obtainCollectors(path string) ([]string, map[string]*immediatesubmitter.CollectorConfig, error) {
+	baseURLs := viper.GetStringSlice(submitterKey(path, "base-urls"))
+	if len(baseURLs) == 0 && viper.GetString(submitterKey(path, "base-url")) != "" {
+		baseURLs = []string{viper.GetString(submitterKey(path, "base-url"))}
+	}
+
+	// Normalise base URLs so that the same collector is only submitted to once.
+	normalisedBaseURLs := make([]string, 0, len(baseURLs))
+	for _, baseURL := range baseURLs {
+		normalisedBaseURL, err := immediatesubmitter.NormaliseBaseURL(baseURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !slices.Contains(normalisedBaseURLs, normalisedBaseURL) {
+			normalisedBaseURLs = append(normalisedBaseURLs, normalisedBaseURL)
+		}
+	}
+	baseURLs = normalisedBaseURLs
+
+	var collectors []*collectorConfig
+	if err := viper.UnmarshalKey(submitterKey(path, "collectors"), &collectors); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid collectors configuration")
+	}
+
+	configs := make(map[string]*immediatesubmitter.CollectorConfig, len(collectors))
+	for i, collector := range collectors {
+		if collector.BaseURL == "" {
+			return nil, nil, fmt.Errorf("collector %d has no base URL", i)
+		}
+		baseURL, err := immediatesubmitter.NormaliseBaseURL(collector.BaseURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		collector.BaseURL = baseURL
+		if _, exists := configs[collector.BaseURL]; exists {
+			return nil, nil, fmt.Errorf("collector %s configured multiple times", collector.BaseURL)
+		}
+
+		bearerToken, err := util.ResolveSecret(collector.BearerToken)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to obtain bearer token for %s", collector.BaseURL)
+		}
+		apiKey, err := util.ResolveSecret(collector.APIKey)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to obtain API key for %s", collector.BaseURL)
+		}
+
+		config := &immediatesubmitter.CollectorConfig{
+			BearerToken:  bearerToken,
+			APIKey:       apiKey,
+			APIKeyHeader: collector.APIKeyHeader,
+			Timeout:      collector.Timeout,
+			Proxy:        collector.Proxy,
+		}
+		if collector.CACert != "" {
+			config.CACertFile = util.ResolvePath(collector.CACert)
+		}
+		if collector.ClientCert != "" {
+			config.ClientCertFile = util.ResolvePath(collector.ClientCert)
+		}
+		if collector.ClientKey != "" {
+			config.ClientKeyFile = util.ResolvePath(collector.ClientKey)
+		}
+		configs[collector.BaseURL] = config
+
+		if !slices.Contains(baseURLs, collector.BaseURL) {
+			baseURLs = append(baseURLs, collector.BaseURL)
+		}
+	}
+
+	return baseURLs, configs, nil
+}
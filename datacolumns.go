@@ -0,0 +1,48 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// custodyConfig is the data column custody configuration for a single node.
+type custodyConfig struct {
+	Address        string `mapstructure:"address"`
+	CustodyColumns uint64 `mapstructure:"custody-columns"`
+}
+
+// obtainCustodyColumns obtains the number of columns custodied by individual nodes.
+func obtainCustodyColumns() (map[string]uint64, error) {
+	var nodes []*custodyConfig
+	if err := viper.UnmarshalKey("datacolumns.nodes", &nodes); err != nil {
+		return nil, errors.Wrap(err, "invalid data column nodes configuration")
+	}
+
+	custodyColumns := make(map[string]uint64, len(nodes))
+	for i, node := range nodes {
+		if node.Address == "" {
+			return nil, fmt.Errorf("data column node %d has no address", i)
+		}
+		if _, exists := custodyColumns[node.Address]; exists {
+			return nil, fmt.Errorf("data column node %s configured multiple times", node.Address)
+		}
+		custodyColumns[node.Address] = node.CustodyColumns
+	}
+
+	return custodyColumns, nil
+}
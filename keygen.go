@@ -0,0 +1,59 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/probec/signing"
+	"github.com/wealdtech/probec/util"
+)
+
+// keygen generates a new signing key and writes it to the configured key file.
+func keygen() error {
+	keyFile := viper.GetString("signing.key-file")
+	if keyFile == "" {
+		keyFile = defaultKeyFile
+	}
+	keyFile = util.ResolvePath(keyFile)
+
+	if _, err := os.Stat(keyFile); err == nil {
+		return fmt.Errorf("key file %s already exists", keyFile)
+	}
+
+	keyPEM, publicKey, err := signing.GenerateKey()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(keyFile), 0o700); err != nil {
+		return errors.Wrap(err, "failed to create key directory")
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write key file")
+	}
+
+	keyID := viper.GetString("signing.key-id")
+	if keyID == "" {
+		keyID = signing.KeyID(publicKey)
+	}
+	fmt.Fprintf(os.Stdout, "Key file: %s\n", keyFile)
+	fmt.Fprintf(os.Stdout, "Key ID: %s\n", keyID)
+	fmt.Fprintf(os.Stdout, "Public key: %#x\n", []byte(publicKey))
+
+	return nil
+}
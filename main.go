@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -20,26 +20,35 @@ import (
 	"os/signal"
 	"runtime"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
 	zerologger "github.com/rs/zerolog/log"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	eventsattestations "github.com/wealdtech/probec/services/attestations/events"
+	eventsblobs "github.com/wealdtech/probec/services/blobs/events"
 	eventsblocks "github.com/wealdtech/probec/services/blocks/events"
 	standardchaintime "github.com/wealdtech/probec/services/chaintime/standard"
+	eventsdatacolumns "github.com/wealdtech/probec/services/datacolumns/events"
+	eventsfinality "github.com/wealdtech/probec/services/finality/events"
 	eventsheads "github.com/wealdtech/probec/services/heads/events"
 	"github.com/wealdtech/probec/services/metrics"
 	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
 	prometheusmetrics "github.com/wealdtech/probec/services/metrics/prometheus"
-	"github.com/wealdtech/probec/services/submitter"
-	consolesubmitter "github.com/wealdtech/probec/services/submitter/console"
-	immediatesubmitter "github.com/wealdtech/probec/services/submitter/immediate"
+	eventsmissedslots "github.com/wealdtech/probec/services/missedslots/events"
+	standardnodestatus "github.com/wealdtech/probec/services/nodestatus/standard"
+	eventsoperations "github.com/wealdtech/probec/services/operations/events"
+	eventspayloadattributes "github.com/wealdtech/probec/services/payloadattributes/events"
+	eventsreorgs "github.com/wealdtech/probec/services/reorgs/events"
+	standardstreams "github.com/wealdtech/probec/services/streams/standard"
+	eventssynccommittee "github.com/wealdtech/probec/services/synccommittee/events"
 	"github.com/wealdtech/probec/util"
 )
 
@@ -117,6 +126,15 @@ func fetchConfig() error {
 	pflag.Bool("blocks.enable", true, "enable logging of block delays")
 	pflag.Bool("heads.enable", true, "enable logging of head delays")
 	pflag.Bool("attestations.enable", false, "enable logging of attestations and their delays")
+	pflag.Bool("reorgs.enable", false, "enable logging of chain reorgs")
+	pflag.Bool("finality.enable", false, "enable logging of finality delays")
+	pflag.Bool("blobs.enable", false, "enable logging of blob sidecar delays")
+	pflag.Bool("datacolumns.enable", false, "enable logging of data column sidecar delays")
+	pflag.Bool("synccommittee.enable", false, "enable logging of sync committee contributions and their delays")
+	pflag.Bool("operations.enable", false, "enable logging of slashing, exit and BLS change propagation")
+	pflag.Bool("payloadattributes.enable", false, "enable logging of payload attributes delays")
+	pflag.Bool("missedslots.enable", false, "enable logging of missed slots and orphaned blocks")
+	pflag.String("signing.key-file", "", "file containing the ed25519 key used to sign submissions (defaults to probec.key in the base directory, if present)")
 	pflag.Parse()
 	if err := viper.BindPFlags(pflag.CommandLine); err != nil {
 		return errors.Wrap(err, "failed to bind pflags to viper")
@@ -144,6 +162,33 @@ func fetchConfig() error {
 	// Defaults.
 	viper.SetDefault("consensusclient.timeout", 2*time.Minute)
 	viper.SetDefault("submitter.style", "immediate")
+	viper.SetDefault("submitter.timeout", 10*time.Second)
+	viper.SetDefault("submitter.queue.dir", "queue")
+	viper.SetDefault("submitter.queue.max-entries", 10000)
+	viper.SetDefault("submitter.queue.max-age", time.Hour)
+	viper.SetDefault("submitter.queue.initial-backoff", time.Second)
+	viper.SetDefault("submitter.queue.max-backoff", 5*time.Minute)
+	viper.SetDefault("submitter.batch.max-items", 100)
+	viper.SetDefault("submitter.batch.interval", 2*time.Second)
+	viper.SetDefault("submitter.file.dir", "data")
+	viper.SetDefault("submitter.file.max-size", 100*1024*1024)
+	viper.SetDefault("submitter.file.max-age", 24*time.Hour)
+	viper.SetDefault("submitter.file.sync-policy", "interval")
+	viper.SetDefault("submitter.file.sync-interval", time.Second)
+	viper.SetDefault("nodestatus.poll-interval", 12*time.Second)
+	viper.SetDefault("nodestatus.optimistic-policy", "reject")
+	viper.SetDefault("nodestatus.max-status-age", time.Minute)
+	viper.SetDefault("attestations.bucket-width", 100*time.Millisecond)
+	viper.SetDefault("attestations.flush-offset", time.Second)
+	viper.SetDefault("finality.late-threshold", 12*time.Second)
+	viper.SetDefault("finality.missing-threshold", 2*time.Minute)
+	viper.SetDefault("datacolumns.custody-columns", 4)
+	viper.SetDefault("synccommittee.flush-offset", time.Second)
+	viper.SetDefault("operations.observation-window", time.Minute)
+	viper.SetDefault("missedslots.check-offset", 4*time.Second)
+	viper.SetDefault("streams.stall-slots", 5)
+	viper.SetDefault("streams.initial-backoff", time.Second)
+	viper.SetDefault("streams.max-backoff", time.Minute)
 
 	if err := viper.ReadInConfig(); err != nil {
 		switch {
@@ -152,7 +197,11 @@ func fetchConfig() error {
 			// we have the information from elsewhere (e.g. environment variables).  Check
 			// to see if we have any submitters configured, as if not we aren't going to
 			// get very far anyway.
-			if viper.Get("submitter.base-urls") == nil && viper.Get("submitter.base-url") == nil {
+			if pflag.NArg() == 0 &&
+				viper.GetString("submitter.style") == "immediate" &&
+				viper.Get("submitter.base-urls") == nil &&
+				viper.Get("submitter.base-url") == nil &&
+				viper.Get("submitter.collectors") == nil {
 				// Assume the underlying issue is that the configuration file is missing.
 				return errors.Wrap(err, "could not find the configuration file")
 			}
@@ -187,35 +236,6 @@ func startMonitor(ctx context.Context) (metrics.Service, error) {
 }
 
 func startServices(ctx context.Context, monitor metrics.Service) error {
-	var submitter submitter.Service
-	var err error
-	switch viper.GetString("submitter.style") {
-	case "immediate":
-		baseUrls := viper.GetStringSlice("submitter.base-urls")
-		if len(baseUrls) == 0 {
-			if viper.GetString("submitter.base-url") == "" {
-				return errors.New("no submitter base URL supplied")
-			}
-			baseUrls = []string{viper.GetString("submitter.base-url")}
-		}
-
-		submitter, err = immediatesubmitter.New(ctx,
-			immediatesubmitter.WithLogLevel(util.LogLevel("submitter.immediate")),
-			immediatesubmitter.WithMonitor(monitor),
-			immediatesubmitter.WithBaseURLs(baseUrls),
-		)
-	case "console":
-		submitter, err = consolesubmitter.New(ctx,
-			consolesubmitter.WithLogLevel(util.LogLevel("submitter.console")),
-			consolesubmitter.WithMonitor(monitor),
-		)
-	default:
-		return fmt.Errorf("unknown submitter %s", viper.GetString("submitter.style"))
-	}
-	if err != nil {
-		return errors.Wrap(err, "failed to start submitter")
-	}
-
 	// Obtain providers.
 	addresses := viper.GetStringSlice("consensusclient.addresses")
 	if len(addresses) == 0 {
@@ -223,6 +243,8 @@ func startServices(ctx context.Context, monitor metrics.Service) error {
 	}
 	eventsProviders := make(map[string]consensusclient.EventsProvider)
 	nodeVersionProviders := make(map[string]consensusclient.NodeVersionProvider)
+	nodeSyncingProviders := make(map[string]consensusclient.NodeSyncingProvider)
+	signedBeaconBlockProviders := make(map[string]consensusclient.SignedBeaconBlockProvider)
 	var firstClient consensusclient.Service
 	for _, address := range addresses {
 		client, err := fetchClient(ctx, address)
@@ -242,6 +264,16 @@ func startServices(ctx context.Context, monitor metrics.Service) error {
 			return fmt.Errorf("%s does not provide node version", address)
 		}
 		nodeVersionProviders[address] = nodeVersionProvider
+		nodeSyncingProvider, isProvider := client.(consensusclient.NodeSyncingProvider)
+		if !isProvider {
+			return fmt.Errorf("%s does not provide node syncing", address)
+		}
+		nodeSyncingProviders[address] = nodeSyncingProvider
+		signedBeaconBlockProvider, isProvider := client.(consensusclient.SignedBeaconBlockProvider)
+		if !isProvider {
+			return fmt.Errorf("%s does not provide signed beacon blocks", address)
+		}
+		signedBeaconBlockProviders[address] = signedBeaconBlockProvider
 	}
 
 	chainTime, err := standardchaintime.New(ctx,
@@ -253,15 +285,52 @@ func startServices(ctx context.Context, monitor metrics.Service) error {
 		return errors.Wrap(err, "failed to create chain time service")
 	}
 
+	submitter, err := startSubmitter(ctx, monitor, chainTime, "submitter")
+	if err != nil {
+		return errors.Wrap(err, "failed to start submitter")
+	}
+
+	nodeStatus, err := standardnodestatus.New(ctx,
+		standardnodestatus.WithLogLevel(util.LogLevel("nodestatus")),
+		standardnodestatus.WithMonitor(monitor),
+		standardnodestatus.WithNodeSyncingProviders(nodeSyncingProviders),
+		standardnodestatus.WithNodeVersionProviders(nodeVersionProviders),
+		standardnodestatus.WithPollInterval(viper.GetDuration("nodestatus.poll-interval")),
+		standardnodestatus.WithOptimisticPolicy(standardnodestatus.OptimisticPolicy(viper.GetString("nodestatus.optimistic-policy"))),
+		standardnodestatus.WithMaxStatusAge(viper.GetDuration("nodestatus.max-status-age")),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create node status service")
+	}
+
+	streams, err := standardstreams.New(ctx,
+		standardstreams.WithLogLevel(util.LogLevel("streams")),
+		standardstreams.WithMonitor(monitor),
+		standardstreams.WithChainTime(chainTime),
+		standardstreams.WithStallSlots(viper.GetUint64("streams.stall-slots")),
+		standardstreams.WithInitialBackoff(viper.GetDuration("streams.initial-backoff")),
+		standardstreams.WithMaxBackoff(viper.GetDuration("streams.max-backoff")),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to create streams service")
+	}
+
 	if viper.GetBool("blocks.enable") {
 		log.Trace().Msg("Starting blocks service")
+		proposerDutiesProvider, isProvider := firstClient.(consensusclient.ProposerDutiesProvider)
+		if !isProvider {
+			return fmt.Errorf("%s does not provide proposer duties", addresses[0])
+		}
 		if _, err := eventsblocks.New(ctx,
 			eventsblocks.WithLogLevel(util.LogLevel("blocks.events")),
 			eventsblocks.WithMonitor(monitor),
 			eventsblocks.WithChainTime(chainTime),
 			eventsblocks.WithEventsProviders(eventsProviders),
-			eventsblocks.WithNodeVersionProviders(nodeVersionProviders),
+			eventsblocks.WithNodeStatus(nodeStatus),
 			eventsblocks.WithSubmitter(submitter),
+			eventsblocks.WithStreams(streams),
+			eventsblocks.WithProposerDutiesProvider(proposerDutiesProvider),
+			eventsblocks.WithSignedBeaconBlockProviders(signedBeaconBlockProviders),
 		); err != nil {
 			return err
 		}
@@ -274,8 +343,9 @@ func startServices(ctx context.Context, monitor metrics.Service) error {
 			eventsheads.WithMonitor(monitor),
 			eventsheads.WithChainTime(chainTime),
 			eventsheads.WithEventsProviders(eventsProviders),
-			eventsheads.WithNodeVersionProviders(nodeVersionProviders),
+			eventsheads.WithNodeStatus(nodeStatus),
 			eventsheads.WithSubmitter(submitter),
+			eventsheads.WithStreams(streams),
 		); err != nil {
 			return err
 		}
@@ -283,13 +353,168 @@ func startServices(ctx context.Context, monitor metrics.Service) error {
 
 	if viper.GetBool("attestations.enable") {
 		log.Trace().Msg("Starting attestations service")
+		validatorIndices := make([]phase0.ValidatorIndex, 0)
+		for _, validator := range viper.GetStringSlice("attestations.validators") {
+			index, err := strconv.ParseUint(validator, 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid validator index %s", validator)
+			}
+			validatorIndices = append(validatorIndices, phase0.ValidatorIndex(index))
+		}
+		// Committees are required to resolve aggregation bits to validator indices.
+		var beaconCommitteesProvider consensusclient.BeaconCommitteesProvider
+		if viper.GetBool("attestations.resolve-validators") || len(validatorIndices) > 0 {
+			var isProvider bool
+			beaconCommitteesProvider, isProvider = firstClient.(consensusclient.BeaconCommitteesProvider)
+			if !isProvider {
+				return fmt.Errorf("%s does not provide beacon committees", addresses[0])
+			}
+		}
 		if _, err := eventsattestations.New(ctx,
 			eventsattestations.WithLogLevel(util.LogLevel("attestations.events")),
 			eventsattestations.WithMonitor(monitor),
 			eventsattestations.WithChainTime(chainTime),
 			eventsattestations.WithEventsProviders(eventsProviders),
-			eventsattestations.WithNodeVersionProviders(nodeVersionProviders),
+			eventsattestations.WithNodeStatus(nodeStatus),
 			eventsattestations.WithSubmitter(submitter),
+			eventsattestations.WithStreams(streams),
+			eventsattestations.WithBucketWidth(viper.GetDuration("attestations.bucket-width")),
+			eventsattestations.WithHorizon(viper.GetDuration("attestations.horizon")),
+			eventsattestations.WithFlushOffset(viper.GetDuration("attestations.flush-offset")),
+			eventsattestations.WithBeaconCommitteesProvider(beaconCommitteesProvider),
+			eventsattestations.WithValidatorIndices(validatorIndices),
+		); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("reorgs.enable") {
+		log.Trace().Msg("Starting reorgs service")
+		if _, err := eventsreorgs.New(ctx,
+			eventsreorgs.WithLogLevel(util.LogLevel("reorgs.events")),
+			eventsreorgs.WithMonitor(monitor),
+			eventsreorgs.WithChainTime(chainTime),
+			eventsreorgs.WithEventsProviders(eventsProviders),
+			eventsreorgs.WithNodeStatus(nodeStatus),
+			eventsreorgs.WithSubmitter(submitter),
+			eventsreorgs.WithStreams(streams),
+		); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("finality.enable") {
+		log.Trace().Msg("Starting finality service")
+		if _, err := eventsfinality.New(ctx,
+			eventsfinality.WithLogLevel(util.LogLevel("finality.events")),
+			eventsfinality.WithMonitor(monitor),
+			eventsfinality.WithChainTime(chainTime),
+			eventsfinality.WithEventsProviders(eventsProviders),
+			eventsfinality.WithNodeStatus(nodeStatus),
+			eventsfinality.WithSubmitter(submitter),
+			eventsfinality.WithStreams(streams),
+			eventsfinality.WithLateThreshold(viper.GetDuration("finality.late-threshold")),
+			eventsfinality.WithMissingThreshold(viper.GetDuration("finality.missing-threshold")),
+		); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("blobs.enable") {
+		log.Trace().Msg("Starting blobs service")
+		if _, err := eventsblobs.New(ctx,
+			eventsblobs.WithLogLevel(util.LogLevel("blobs.events")),
+			eventsblobs.WithMonitor(monitor),
+			eventsblobs.WithChainTime(chainTime),
+			eventsblobs.WithEventsProviders(eventsProviders),
+			eventsblobs.WithNodeStatus(nodeStatus),
+			eventsblobs.WithSubmitter(submitter),
+			eventsblobs.WithStreams(streams),
+		); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("datacolumns.enable") {
+		log.Trace().Msg("Starting data columns service")
+		var custodyColumns map[string]uint64
+		custodyColumns, err = obtainCustodyColumns()
+		if err != nil {
+			return err
+		}
+		if _, err := eventsdatacolumns.New(ctx,
+			eventsdatacolumns.WithLogLevel(util.LogLevel("datacolumns.events")),
+			eventsdatacolumns.WithMonitor(monitor),
+			eventsdatacolumns.WithChainTime(chainTime),
+			eventsdatacolumns.WithEventsProviders(eventsProviders),
+			eventsdatacolumns.WithNodeStatus(nodeStatus),
+			eventsdatacolumns.WithSubmitter(submitter),
+			eventsdatacolumns.WithStreams(streams),
+			eventsdatacolumns.WithCustodyColumns(custodyColumns),
+			eventsdatacolumns.WithDefaultCustodyColumns(viper.GetUint64("datacolumns.custody-columns")),
+		); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("synccommittee.enable") {
+		log.Trace().Msg("Starting sync committee service")
+		if _, err := eventssynccommittee.New(ctx,
+			eventssynccommittee.WithLogLevel(util.LogLevel("synccommittee.events")),
+			eventssynccommittee.WithMonitor(monitor),
+			eventssynccommittee.WithChainTime(chainTime),
+			eventssynccommittee.WithEventsProviders(eventsProviders),
+			eventssynccommittee.WithNodeStatus(nodeStatus),
+			eventssynccommittee.WithSubmitter(submitter),
+			eventssynccommittee.WithStreams(streams),
+			eventssynccommittee.WithFlushOffset(viper.GetDuration("synccommittee.flush-offset")),
+		); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("operations.enable") {
+		log.Trace().Msg("Starting operations service")
+		if _, err := eventsoperations.New(ctx,
+			eventsoperations.WithLogLevel(util.LogLevel("operations.events")),
+			eventsoperations.WithMonitor(monitor),
+			eventsoperations.WithChainTime(chainTime),
+			eventsoperations.WithEventsProviders(eventsProviders),
+			eventsoperations.WithNodeStatus(nodeStatus),
+			eventsoperations.WithSubmitter(submitter),
+			eventsoperations.WithStreams(streams),
+			eventsoperations.WithObservationWindow(viper.GetDuration("operations.observation-window")),
+		); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("payloadattributes.enable") {
+		log.Trace().Msg("Starting payload attributes service")
+		if _, err := eventspayloadattributes.New(ctx,
+			eventspayloadattributes.WithLogLevel(util.LogLevel("payloadattributes.events")),
+			eventspayloadattributes.WithMonitor(monitor),
+			eventspayloadattributes.WithChainTime(chainTime),
+			eventspayloadattributes.WithEventsProviders(eventsProviders),
+			eventspayloadattributes.WithNodeStatus(nodeStatus),
+			eventspayloadattributes.WithSubmitter(submitter),
+			eventspayloadattributes.WithStreams(streams),
+		); err != nil {
+			return err
+		}
+	}
+
+	if viper.GetBool("missedslots.enable") {
+		log.Trace().Msg("Starting missed slots service")
+		if _, err := eventsmissedslots.New(ctx,
+			eventsmissedslots.WithLogLevel(util.LogLevel("missedslots.events")),
+			eventsmissedslots.WithMonitor(monitor),
+			eventsmissedslots.WithChainTime(chainTime),
+			eventsmissedslots.WithEventsProviders(eventsProviders),
+			eventsmissedslots.WithNodeStatus(nodeStatus),
+			eventsmissedslots.WithSubmitter(submitter),
+			eventsmissedslots.WithStreams(streams),
+			eventsmissedslots.WithCheckOffset(viper.GetDuration("missedslots.check-offset")),
 		); err != nil {
 			return err
 		}
@@ -317,4 +542,18 @@ func runCommands(_ context.Context) {
 		fmt.Fprintf(os.Stdout, "%s\n", ReleaseVersion)
 		os.Exit(0)
 	}
+
+	switch pflag.Arg(0) {
+	case "":
+		// No command.
+	case "keygen":
+		if err := keygen(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to generate key: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %s\n", pflag.Arg(0))
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,105 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBucket(t *testing.T) {
+	tests := []struct {
+		name        string
+		bucketWidth time.Duration
+		numBuckets  int
+		delay       time.Duration
+		bucket      int
+		exists      bool
+	}{
+		{
+			name:        "Negative",
+			bucketWidth: 100 * time.Millisecond,
+			numBuckets:  120,
+			delay:       -time.Millisecond,
+		},
+		{
+			name:        "Zero",
+			bucketWidth: 100 * time.Millisecond,
+			numBuckets:  120,
+			delay:       0,
+			bucket:      0,
+			exists:      true,
+		},
+		{
+			name:        "EndOfFirstBucket",
+			bucketWidth: 100 * time.Millisecond,
+			numBuckets:  120,
+			delay:       99 * time.Millisecond,
+			bucket:      0,
+			exists:      true,
+		},
+		{
+			name:        "StartOfSecondBucket",
+			bucketWidth: 100 * time.Millisecond,
+			numBuckets:  120,
+			delay:       100 * time.Millisecond,
+			bucket:      1,
+			exists:      true,
+		},
+		{
+			name:        "Mid",
+			bucketWidth: 100 * time.Millisecond,
+			numBuckets:  120,
+			delay:       4321 * time.Millisecond,
+			bucket:      43,
+			exists:      true,
+		},
+		{
+			name:        "LastBucket",
+			bucketWidth: 100 * time.Millisecond,
+			numBuckets:  120,
+			delay:       11999 * time.Millisecond,
+			bucket:      119,
+			exists:      true,
+		},
+		{
+			name:        "Horizon",
+			bucketWidth: 100 * time.Millisecond,
+			numBuckets:  120,
+			delay:       12 * time.Second,
+		},
+		{
+			name:        "WideBuckets",
+			bucketWidth: 250 * time.Millisecond,
+			numBuckets:  48,
+			delay:       4321 * time.Millisecond,
+			bucket:      17,
+			exists:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				bucketWidth: test.bucketWidth,
+				numBuckets:  test.numBuckets,
+			}
+			bucket, exists := s.bucket(test.delay)
+			require.Equal(t, test.exists, exists)
+			require.Equal(t, test.bucket, bucket)
+		})
+	}
+}
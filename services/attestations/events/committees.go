@@ -0,0 +1,247 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// epochCommittees are the beacon committees for an epoch, which are fetched once per epoch.
+type epochCommittees struct {
+	// done is closed once the committees have been fetched.
+	done       chan struct{}
+	committees map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex
+	err        error
+	// failedSlot is the slot in which the fetch failed, if it failed.
+	failedSlot phase0.Slot
+}
+
+// committee returns the validators in the given committee, fetching and
+// caching the beacon committees for its epoch if required.
+func (s *Service) committee(ctx context.Context,
+	slot phase0.Slot,
+	committeeIndex phase0.CommitteeIndex,
+) (
+	[]phase0.ValidatorIndex,
+	error,
+) {
+	committees, err := s.epochCommittees(ctx, s.chainTime.SlotToEpoch(slot))
+	if err != nil {
+		return nil, err
+	}
+
+	committee, exists := committees[slot][committeeIndex]
+	if !exists {
+		return nil, fmt.Errorf("no committee %d for slot %d", committeeIndex, slot)
+	}
+
+	return committee, nil
+}
+
+// epochCommittees returns the beacon committees for the given epoch.  The
+// committees are fetched without holding the lock, so that a slow node does
+// not block other users of the cache, and concurrent callers wait for the
+// single fetch in flight rather than making their own.  A failed fetch is
+// remembered until the following slot, so that an unresponsive node is not
+// asked again for every committee in a slot.
+func (s *Service) epochCommittees(ctx context.Context,
+	epoch phase0.Epoch,
+) (
+	map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex,
+	error,
+) {
+	s.committeesMu.Lock()
+	entry, exists := s.beaconCommittees[epoch]
+	if exists && !s.retryCommittees(entry) {
+		s.committeesMu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-entry.done:
+		}
+
+		return entry.committees, entry.err
+	}
+	entry = &epochCommittees{
+		done: make(chan struct{}),
+	}
+	s.beaconCommittees[epoch] = entry
+	// Only the current and previous epochs are of interest.
+	for committeesEpoch := range s.beaconCommittees {
+		if committeesEpoch+1 < epoch {
+			delete(s.beaconCommittees, committeesEpoch)
+		}
+	}
+	s.committeesMu.Unlock()
+
+	entry.committees, entry.err = s.fetchCommittees(ctx, epoch)
+	if entry.err != nil {
+		entry.failedSlot = s.chainTime.CurrentSlot()
+	}
+	close(entry.done)
+
+	return entry.committees, entry.err
+}
+
+// retryCommittees returns true if the given entry holds a fetch that failed
+// in an earlier slot, and so should be fetched again.
+func (s *Service) retryCommittees(entry *epochCommittees) bool {
+	select {
+	case <-entry.done:
+		return entry.err != nil && s.chainTime.CurrentSlot() > entry.failedSlot
+	default:
+		// Fetch in flight.
+		return false
+	}
+}
+
+// fetchCommittees fetches the beacon committees for the given epoch.
+func (s *Service) fetchCommittees(ctx context.Context,
+	epoch phase0.Epoch,
+) (
+	map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex,
+	error,
+) {
+	response, err := s.beaconCommitteesProvider.BeaconCommittees(ctx, &api.BeaconCommitteesOpts{
+		State: "head",
+		Epoch: &epoch,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon committees")
+	}
+	committees := make(map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex)
+	for _, committee := range response.Data {
+		if _, exists := committees[committee.Slot]; !exists {
+			committees[committee.Slot] = make(map[phase0.CommitteeIndex][]phase0.ValidatorIndex)
+		}
+		committees[committee.Slot][committee.Index] = committee.Validators
+	}
+
+	return committees, nil
+}
+
+// attestingIndices returns the validators whose bits are set in the given
+// aggregation bits, which span the given committees in order.
+func (s *Service) attestingIndices(ctx context.Context,
+	slot phase0.Slot,
+	committeeIndices []phase0.CommitteeIndex,
+	aggregationBits bitfield.Bitlist,
+) (
+	[]phase0.ValidatorIndex,
+	error,
+) {
+	indices := make([]phase0.ValidatorIndex, 0, aggregationBits.Count())
+	offset := uint64(0)
+	for _, committeeIndex := range committeeIndices {
+		committee, err := s.committee(ctx, slot, committeeIndex)
+		if err != nil {
+			return nil, err
+		}
+		for i := range committee {
+			if aggregationBits.BitAt(offset + uint64(i)) {
+				indices = append(indices, committee[i])
+			}
+		}
+		offset += uint64(len(committee))
+	}
+	if offset != aggregationBits.Len() {
+		return nil, fmt.Errorf("aggregation bits length %d does not match committee size %d", aggregationBits.Len(), offset)
+	}
+
+	return indices, nil
+}
+
+// resolveAttesters adds the validators in the buckets of the summary to its
+// attesters, along with the earliest bucket in which each was seen.  If the
+// committee cannot be obtained the summary is left unresolved.
+func (s *Service) resolveAttesters(ctx context.Context,
+	slot phase0.Slot,
+	summary *attestationSummary,
+) error {
+	resolved := make(map[string]map[phase0.ValidatorIndex]uint64, len(summary.buckets))
+	for address, buckets := range summary.buckets {
+		attesters := make(map[phase0.ValidatorIndex]uint64)
+		for bucket, bits := range buckets {
+			if bits == nil {
+				continue
+			}
+			indices, err := s.attestingIndices(ctx, slot, []phase0.CommitteeIndex{summary.committee}, bits)
+			if err != nil {
+				return err
+			}
+			for _, index := range indices {
+				if _, exists := attesters[index]; !exists {
+					attesters[index] = uint64(bucket)
+				}
+			}
+		}
+		resolved[address] = attesters
+	}
+
+	for address, attesters := range resolved {
+		summaryAttesters, exists := summary.attesters[address]
+		if !exists {
+			summaryAttesters = make(map[phase0.ValidatorIndex]uint64, len(attesters))
+			summary.attesters[address] = summaryAttesters
+		}
+		for index, bucket := range attesters {
+			if existing, exists := summaryAttesters[index]; !exists || bucket < existing {
+				summaryAttesters[index] = bucket
+			}
+		}
+	}
+	summary.resolved = true
+
+	return nil
+}
+
+// restrictAttesters restricts the summary to the configured validators,
+// returning false if none of them are present.  Aggregation bits would reveal
+// the votes of other validators, so are removed in favour of the attesters.
+func (s *Service) restrictAttesters(summary *attestationSummary) bool {
+	summary.buckets = make(map[string][]bitfield.Bitlist)
+
+	present := false
+	for address, attesters := range summary.attesters {
+		for index := range attesters {
+			if _, exists := s.validators[index]; !exists {
+				delete(attesters, index)
+			}
+		}
+		if len(attesters) == 0 {
+			delete(summary.attesters, address)
+			continue
+		}
+		present = true
+	}
+
+	return present
+}
+
+// includesValidator returns true if any of the given validators are in the configured validators.
+func (s *Service) includesValidator(indices []phase0.ValidatorIndex) bool {
+	for _, index := range indices {
+		if _, exists := s.validators[index]; exists {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,322 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+	standardchaintime "github.com/wealdtech/probec/services/chaintime/standard"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+// fetchedCommittees returns a cache entry for the given committees.
+func fetchedCommittees(committees map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex) *epochCommittees {
+	entry := &epochCommittees{
+		done:       make(chan struct{}),
+		committees: committees,
+	}
+	close(entry.done)
+
+	return entry
+}
+
+// committeesProvider provides beacon committees once released, counting the requests made.
+type committeesProvider struct {
+	release  chan struct{}
+	err      error
+	requests atomic.Int32
+}
+
+func (p *committeesProvider) BeaconCommittees(ctx context.Context,
+	opts *api.BeaconCommitteesOpts,
+) (
+	*api.Response[[]*apiv1.BeaconCommittee],
+	error,
+) {
+	p.requests.Add(1)
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.release:
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return &api.Response[[]*apiv1.BeaconCommittee]{
+		Data: []*apiv1.BeaconCommittee{
+			{
+				Slot:       phase0.Slot(*opts.Epoch) * 32,
+				Index:      0,
+				Validators: []phase0.ValidatorIndex{1, 2, 3},
+			},
+		},
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+func TestCommitteesFetchedOnce(t *testing.T) {
+	ctx := context.Background()
+
+	provider := &committeesProvider{release: make(chan struct{})}
+	s := &Service{
+		chainTime:                createChainTime(t, time.Second),
+		beaconCommitteesProvider: provider,
+		beaconCommittees:         make(map[phase0.Epoch]*epochCommittees),
+	}
+
+	// Concurrent callers share a single fetch.
+	committees := make([][]phase0.ValidatorIndex, 10)
+	errs := make([]error, 10)
+	var wg sync.WaitGroup
+	for i := range committees {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			committees[i], errs[i] = s.committee(ctx, 64, 0)
+		}(i)
+	}
+	require.Eventually(t, func() bool {
+		return provider.requests.Load() == 1
+	}, time.Second, time.Millisecond)
+	close(provider.release)
+	wg.Wait()
+	require.Equal(t, int32(1), provider.requests.Load())
+	for i := range committees {
+		require.NoError(t, errs[i])
+		require.Equal(t, []phase0.ValidatorIndex{1, 2, 3}, committees[i])
+	}
+
+	// A failed fetch is remembered for the rest of the slot.
+	provider.err = errors.New("unavailable")
+	_, err := s.committee(ctx, 96, 0)
+	require.EqualError(t, err, "failed to obtain beacon committees: unavailable")
+	provider.err = nil
+	_, err = s.committee(ctx, 96, 1)
+	require.EqualError(t, err, "failed to obtain beacon committees: unavailable")
+	require.Equal(t, int32(2), provider.requests.Load())
+
+	// It is fetched again in a later slot.
+	time.Sleep(time.Until(s.chainTime.StartOfSlot(s.chainTime.CurrentSlot() + 1)))
+	_, err = s.committee(ctx, 96, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), provider.requests.Load())
+}
+
+func TestFlushUnreachable(t *testing.T) {
+	ctx := context.Background()
+
+	recorder := mocksubmitter.NewRecorder()
+	s := newFlushTestService(createChainTime(t, 12*time.Second), recorder, time.Second)
+	provider := &committeesProvider{
+		release: make(chan struct{}),
+		err:     errors.New("unavailable"),
+	}
+	close(provider.release)
+	s.beaconCommitteesProvider = provider
+	s.beaconCommittees = make(map[phase0.Epoch]*epochCommittees)
+
+	// Summaries for many committees in a slot result in a single request.
+	bits := bitfield.NewBitlist(3)
+	bits.SetBitAt(1, true)
+	slotSummaries := make(map[string]*attestationSummary)
+	for i := range 64 {
+		slotSummaries[fmt.Sprintf("%d", i)] = &attestationSummary{
+			committee: phase0.CommitteeIndex(i),
+			buckets: map[string][]bitfield.Bitlist{
+				"a": {bits},
+			},
+			attesters: map[string]map[phase0.ValidatorIndex]uint64{},
+		}
+	}
+	s.attestationSummaries[1] = slotSummaries
+	s.flushSlot(ctx, 1)
+	summaries := recorder.AttestationSummaries()
+	require.Len(t, summaries, 1)
+	require.Len(t, summaries[0].Attestations, 64)
+	require.Equal(t, int32(1), provider.requests.Load())
+}
+
+func TestCommittees(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisProvider(mockClient),
+		standardchaintime.WithSpecProvider(mockClient),
+		standardchaintime.WithForkScheduleProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	s := &Service{
+		chainTime:                chainTime,
+		beaconCommitteesProvider: mockClient,
+		validators: map[phase0.ValidatorIndex]struct{}{
+			12: {},
+			21: {},
+		},
+		beaconCommittees: map[phase0.Epoch]*epochCommittees{
+			0: fetchedCommittees(map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex{
+				1: {
+					0: {10, 11, 12, 13},
+					1: {20, 21, 22},
+				},
+			}),
+		},
+	}
+
+	bits := func(length uint64, indices ...uint64) bitfield.Bitlist {
+		bl := bitfield.NewBitlist(length)
+		for _, index := range indices {
+			bl.SetBitAt(index, true)
+		}
+
+		return bl
+	}
+
+	// Single committee.
+	indices, err := s.attestingIndices(ctx, 1, []phase0.CommitteeIndex{0}, bits(4, 1, 2))
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{11, 12}, indices)
+
+	// Multiple committees.
+	indices, err = s.attestingIndices(ctx, 1, []phase0.CommitteeIndex{0, 1}, bits(7, 0, 5))
+	require.NoError(t, err)
+	require.Equal(t, []phase0.ValidatorIndex{10, 21}, indices)
+	require.True(t, s.includesValidator(indices))
+
+	// Length mismatch.
+	_, err = s.attestingIndices(ctx, 1, []phase0.CommitteeIndex{0}, bits(5, 1))
+	require.EqualError(t, err, "aggregation bits length 5 does not match committee size 4")
+
+	// Unknown committee.
+	_, err = s.attestingIndices(ctx, 1, []phase0.CommitteeIndex{2}, bits(4, 1))
+	require.EqualError(t, err, "no committee 2 for slot 1")
+
+	// Resolution takes the earliest bucket for each attester, and restriction
+	// removes other validators and the aggregation bits.
+	summary := &attestationSummary{
+		committee: 0,
+		buckets: map[string][]bitfield.Bitlist{
+			"a": {nil, bits(4, 0, 2), bits(4, 2, 3)},
+		},
+		attesters: map[string]map[phase0.ValidatorIndex]uint64{
+			"a": {12: 2},
+		},
+	}
+	require.NoError(t, s.resolveAttesters(ctx, 1, summary))
+	require.True(t, summary.resolved)
+	require.Equal(t, map[phase0.ValidatorIndex]uint64{10: 1, 12: 1, 13: 2}, summary.attesters["a"])
+	require.Equal(t, map[string]uint64{"a": 3}, summary.attesterCounts())
+	require.True(t, s.restrictAttesters(summary))
+	require.Empty(t, summary.buckets)
+	require.Equal(t, map[phase0.ValidatorIndex]uint64{12: 1}, summary.attesters["a"])
+
+	// A summary without the configured validators is dropped.
+	summary = &attestationSummary{
+		committee: 1,
+		buckets: map[string][]bitfield.Bitlist{
+			"a": {bits(3, 0)},
+		},
+		attesters: map[string]map[phase0.ValidatorIndex]uint64{},
+	}
+	require.NoError(t, s.resolveAttesters(ctx, 1, summary))
+	require.False(t, s.restrictAttesters(summary))
+
+	// A summary whose committee is unknown is left unresolved.
+	summary = &attestationSummary{
+		committee: 2,
+		buckets: map[string][]bitfield.Bitlist{
+			"a": {bits(4, 0)},
+		},
+		attesters: map[string]map[phase0.ValidatorIndex]uint64{},
+	}
+	require.EqualError(t, s.resolveAttesters(ctx, 1, summary), "no committee 2 for slot 1")
+	require.False(t, summary.resolved)
+	require.Empty(t, summary.attesters)
+}
+
+func TestFlushUnresolved(t *testing.T) {
+	ctx := context.Background()
+
+	// Use an unregistered metric, restoring the original afterwards.
+	originalResolutionFails := resolutionFails
+	resolutionFails = prometheus.NewCounter(prometheus.CounterOpts{Name: "resolution_failures_total"})
+	defer func() {
+		resolutionFails = originalResolutionFails
+	}()
+
+	recorder := mocksubmitter.NewRecorder()
+	s := newFlushTestService(createChainTime(t, 12*time.Second), recorder, time.Second)
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+	s.beaconCommitteesProvider = mockClient
+	// The committee for the summary added below is not present.
+	s.beaconCommittees = map[phase0.Epoch]*epochCommittees{
+		0: fetchedCommittees(map[phase0.Slot]map[phase0.CommitteeIndex][]phase0.ValidatorIndex{
+			1: {
+				1: {20, 21, 22},
+			},
+		}),
+	}
+
+	// Without restriction, summaries that cannot be resolved are submitted
+	// with their buckets intact.
+	s.addSummary(1)
+	s.flushSlot(ctx, 1)
+	summaries := recorder.AttestationSummaries()
+	require.Len(t, summaries, 1)
+	require.Len(t, summaries[0].Attestations, 1)
+	require.Len(t, summaries[0].Attestations[0].Buckets["a"], 1)
+	require.Empty(t, summaries[0].Attestations[0].Attesters)
+	require.Equal(t, float64(1), testutil.ToFloat64(resolutionFails))
+
+	// With restriction they are dropped, as their buckets would reveal the
+	// votes of other validators.
+	s.validators = map[phase0.ValidatorIndex]struct{}{12: {}}
+	s.addSummary(1)
+	s.flushSlot(ctx, 1)
+	summaries = recorder.AttestationSummaries()
+	require.Len(t, summaries, 2)
+	require.Empty(t, summaries[1].Attestations)
+	require.Equal(t, float64(2), testutil.ToFloat64(resolutionFails))
+
+	// As are aggregates.
+	bits := bitfield.NewBitlist(4)
+	bits.SetBitAt(1, true)
+	bits.SetBitAt(2, true)
+	s.handleAggregateAttestation(ctx, "a", &phase0.AttestationData{
+		Slot:   1,
+		Source: &phase0.Checkpoint{},
+		Target: &phase0.Checkpoint{},
+	}, []phase0.CommitteeIndex{0}, bits, time.Second)
+	require.Empty(t, recorder.AggregateAttestations())
+	require.Equal(t, float64(3), testutil.ToFloat64(resolutionFails))
+}
@@ -0,0 +1,162 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/chaintime"
+	mockchaintime "github.com/wealdtech/probec/services/chaintime/mock"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	"github.com/wealdtech/probec/services/submitter"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+// timedRecorder records when attestation summaries are submitted, as well as the data points.
+type timedRecorder struct {
+	*mocksubmitter.Recorder
+	mu        sync.Mutex
+	submitted []time.Time
+}
+
+func (r *timedRecorder) SubmitAttestationSummary(ctx context.Context, data *submitter.AttestationSummary) {
+	r.mu.Lock()
+	r.submitted = append(r.submitted, time.Now())
+	r.mu.Unlock()
+	r.Recorder.SubmitAttestationSummary(ctx, data)
+}
+
+// createChainTime creates a chain time service with the given slot duration.
+func createChainTime(t *testing.T, slotDuration time.Duration) chaintime.Service {
+	t.Helper()
+
+	chainTime, err := mockchaintime.NewStandard(time.Now(), slotDuration)
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func newFlushTestService(chainTime chaintime.Service, recorder submitter.Service, flushOffset time.Duration) *Service {
+	return &Service{
+		chainTime:            chainTime,
+		submitter:            recorder,
+		nodeStatus:           mocknodestatus.New(),
+		bucketWidth:          100 * time.Millisecond,
+		flushOffset:          flushOffset,
+		attestationSummaries: make(map[phase0.Slot]map[string]*attestationSummary),
+		heads:                make(map[string]map[phase0.Slot]phase0.Root),
+	}
+}
+
+// addSummary adds a summary with a single attester seen by a single node for the given slot.
+func (s *Service) addSummary(slot phase0.Slot) {
+	bits := bitfield.NewBitlist(8)
+	bits.SetBitAt(1, true)
+
+	s.attestationsMu.Lock()
+	defer s.attestationsMu.Unlock()
+	s.attestationSummaries[slot] = map[string]*attestationSummary{
+		"0": {
+			beaconBlockRoot: phase0.Root{0x01},
+			targetRoot:      phase0.Root{0x02},
+			buckets: map[string][]bitfield.Bitlist{
+				"a": {bits},
+			},
+		},
+	}
+}
+
+func TestFlushSlot(t *testing.T) {
+	ctx := context.Background()
+
+	// Use unregistered metrics, restoring the originals afterwards.
+	originalSummarySlots := summarySlots
+	originalSlotsEvicted := slotsEvicted
+	summarySlots = prometheus.NewGauge(prometheus.GaugeOpts{Name: "summary_slots"})
+	slotsEvicted = prometheus.NewCounter(prometheus.CounterOpts{Name: "slots_evicted_total"})
+	defer func() {
+		summarySlots = originalSummarySlots
+		slotsEvicted = originalSlotsEvicted
+	}()
+
+	recorder := mocksubmitter.NewRecorder()
+	s := newFlushTestService(createChainTime(t, 12*time.Second), recorder, time.Second)
+
+	// Slots 5 and 9 are stale by the time slot 10 is flushed; slot 11 is yet to be flushed.
+	for _, slot := range []phase0.Slot{5, 9, 10, 11} {
+		s.addSummary(slot)
+	}
+
+	s.flushSlot(ctx, 10)
+	summaries := recorder.AttestationSummaries()
+	require.Len(t, summaries, 1)
+	summary := summaries[0]
+	require.Equal(t, phase0.Slot(10), summary.Slot)
+	require.Equal(t, 100*time.Millisecond, summary.BucketWidth)
+	require.Len(t, summary.Attestations, 1)
+	require.Equal(t, phase0.Root{0x01}, summary.Attestations[0].BeaconBlockRoot)
+
+	// Stale slots are evicted without being submitted.
+	require.Len(t, s.attestationSummaries, 1)
+	require.Contains(t, s.attestationSummaries, phase0.Slot(11))
+	require.Equal(t, float64(1), testutil.ToFloat64(summarySlots))
+	require.Equal(t, float64(2), testutil.ToFloat64(slotsEvicted))
+
+	// Flushing a slot without summaries submits nothing, but still evicts earlier slots.
+	s.addSummary(11)
+	s.flushSlot(ctx, 12)
+	require.Len(t, recorder.AttestationSummaries(), 1)
+	require.Empty(t, s.attestationSummaries)
+	require.Equal(t, float64(0), testutil.ToFloat64(summarySlots))
+	require.Equal(t, float64(3), testutil.ToFloat64(slotsEvicted))
+}
+
+func TestFlushSummaries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	slotDuration := time.Second
+	flushOffset := 200 * time.Millisecond
+	chainTime := createChainTime(t, slotDuration)
+
+	recorder := &timedRecorder{Recorder: mocksubmitter.NewRecorder()}
+	s := newFlushTestService(chainTime, recorder, flushOffset)
+
+	// Add summaries for this slot and the next, which are flushed in turn.
+	slot := chainTime.CurrentSlot()
+	s.addSummary(slot)
+	s.addSummary(slot + 1)
+
+	go s.flushSummaries(ctx)
+
+	require.Eventually(t, func() bool {
+		return len(recorder.AttestationSummaries()) == 2
+	}, 5*slotDuration, 10*time.Millisecond)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for i, summary := range recorder.AttestationSummaries() {
+		require.Equal(t, slot+phase0.Slot(i), summary.Slot)
+		// Each summary is submitted once the flush offset after the end of its slot has passed.
+		require.False(t, recorder.submitted[i].Before(chainTime.StartOfSlot(summary.Slot+1).Add(flushOffset)))
+	}
+}
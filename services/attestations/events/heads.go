@@ -0,0 +1,182 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// handleHead records the new head of a node.
+func (s *Service) handleHead(address string, event *apiv1.HeadEvent) {
+	if !s.nodeStatus.Submittable(address) {
+		return
+	}
+
+	s.headsMu.Lock()
+	defer s.headsMu.Unlock()
+
+	heads, exists := s.heads[address]
+	if !exists {
+		heads = make(map[phase0.Slot]phase0.Root)
+		s.heads[address] = heads
+	}
+	heads[event.Slot] = event.Block
+}
+
+// handleChainReorg removes the orphaned branch from the heads of a node, as
+// it is no longer part of its chain.
+func (s *Service) handleChainReorg(address string, event *apiv1.ChainReorgEvent) {
+	ancestorSlot := phase0.Slot(0)
+	if uint64(event.Slot) > event.Depth {
+		ancestorSlot = event.Slot - phase0.Slot(event.Depth)
+	}
+
+	s.headsMu.Lock()
+	defer s.headsMu.Unlock()
+
+	// The orphaned branch runs from the old head back to the common ancestor,
+	// so contains the heads of the node after the ancestor other than the new
+	// head.  The old head is removed regardless of its slot, as the reorg
+	// event does not provide it.
+	for slot, root := range s.heads[address] {
+		if root == event.OldHeadBlock || (slot > ancestorSlot && root != event.NewHeadBlock) {
+			delete(s.heads[address], slot)
+		}
+	}
+}
+
+// pruneHeads removes the heads that are no longer required to obtain the
+// head at or after the given slot.
+func (s *Service) pruneHeads(slot phase0.Slot) {
+	s.headsMu.Lock()
+	defer s.headsMu.Unlock()
+
+	for _, heads := range s.heads {
+		headSlot, exists := latestHeadSlot(heads, slot)
+		if !exists {
+			continue
+		}
+		for candidateSlot := range heads {
+			if candidateSlot < headSlot {
+				delete(heads, candidateSlot)
+			}
+		}
+	}
+}
+
+// latestHeadSlot returns the slot of the latest head at or before the given slot.
+func latestHeadSlot(heads map[phase0.Slot]phase0.Root, slot phase0.Slot) (phase0.Slot, bool) {
+	found := false
+	headSlot := phase0.Slot(0)
+	for candidateSlot := range heads {
+		if candidateSlot <= slot && (!found || candidateSlot > headSlot) {
+			found = true
+			headSlot = candidateSlot
+		}
+	}
+
+	return headSlot, found
+}
+
+// voteCorrectness checks the votes for a slot against the view of the chain
+// of each node that saw them.  Nodes without a view of both the head and the
+// target are not included.
+func (s *Service) voteCorrectness(slot phase0.Slot,
+	summaries map[string]*attestationSummary,
+) map[string]*submitter.AttestationVoteCorrectness {
+	targetSlot := s.chainTime.FirstSlotOfEpoch(s.chainTime.SlotToEpoch(slot))
+
+	type chainView struct {
+		head   phase0.Root
+		target phase0.Root
+	}
+	views := make(map[string]chainView)
+	s.headsMu.Lock()
+	for address, heads := range s.heads {
+		headSlot, headExists := latestHeadSlot(heads, slot)
+		checkpointSlot, targetExists := latestHeadSlot(heads, targetSlot)
+		if headExists && targetExists {
+			views[address] = chainView{
+				head:   heads[headSlot],
+				target: heads[checkpointSlot],
+			}
+		}
+	}
+	s.headsMu.Unlock()
+
+	correctness := make(map[string]*submitter.AttestationVoteCorrectness)
+	for _, summary := range summaries {
+		for address, attesters := range summary.attesterCounts() {
+			view, exists := views[address]
+			if !exists {
+				continue
+			}
+			addressCorrectness, exists := correctness[address]
+			if !exists {
+				addressCorrectness = &submitter.AttestationVoteCorrectness{}
+				correctness[address] = addressCorrectness
+			}
+			switch {
+			case summary.targetRoot != view.target:
+				addressCorrectness.WrongTarget += attesters
+			case summary.beaconBlockRoot != view.head:
+				addressCorrectness.WrongHead += attesters
+			default:
+				addressCorrectness.Correct += attesters
+			}
+		}
+	}
+	if len(correctness) == 0 {
+		return nil
+	}
+
+	return correctness
+}
+
+// attesterCounts returns the number of distinct attesters seen by each node.
+func (a *attestationSummary) attesterCounts() map[string]uint64 {
+	counts := make(map[string]uint64)
+	for address, attesters := range a.attesters {
+		counts[address] += uint64(len(attesters))
+	}
+	if a.resolved {
+		// The attesters already include those in the buckets.
+		return counts
+	}
+	for address, buckets := range a.buckets {
+		var seen bitfield.Bitlist
+		for _, bits := range buckets {
+			switch {
+			case bits == nil:
+			case seen == nil:
+				seen = bits
+			default:
+				merged, err := seen.Or(bits)
+				if err != nil {
+					log.Debug().Err(err).Msg("Failed to merge aggregation bits; ignoring")
+					continue
+				}
+				seen = merged
+			}
+		}
+		if seen != nil {
+			counts[address] += seen.Count()
+		}
+	}
+
+	return counts
+}
@@ -0,0 +1,140 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"testing"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+	standardchaintime "github.com/wealdtech/probec/services/chaintime/standard"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+func TestVoteCorrectness(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisProvider(mockClient),
+		standardchaintime.WithSpecProvider(mockClient),
+		standardchaintime.WithForkScheduleProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	s := &Service{
+		chainTime:  chainTime,
+		nodeStatus: mocknodestatus.New(),
+		heads:      make(map[string]map[phase0.Slot]phase0.Root),
+	}
+
+	target := phase0.Root{0x01}
+	head := phase0.Root{0x02}
+	orphan := phase0.Root{0x03}
+	other := phase0.Root{0x04}
+
+	// Node a sees the target at the first slot of the epoch, and the head
+	// some slots later, having had an orphan briefly as its head.
+	s.handleHead("a", &apiv1.HeadEvent{Slot: 32, Block: target})
+	s.handleHead("a", &apiv1.HeadEvent{Slot: 36, Block: orphan})
+	s.handleChainReorg("a", &apiv1.ChainReorgEvent{Slot: 37, Depth: 1, OldHeadBlock: orphan})
+	s.handleHead("a", &apiv1.HeadEvent{Slot: 37, Block: head})
+	// Node b has only seen the head, so cannot check the target.
+	s.handleHead("b", &apiv1.HeadEvent{Slot: 37, Block: head})
+
+	bits := func(indices ...uint64) bitfield.Bitlist {
+		bl := bitfield.NewBitlist(8)
+		for _, index := range indices {
+			bl.SetBitAt(index, true)
+		}
+
+		return bl
+	}
+	summaries := map[string]*attestationSummary{
+		"correct": {
+			beaconBlockRoot: head,
+			targetRoot:      target,
+			buckets: map[string][]bitfield.Bitlist{
+				"a": {bits(0, 1), nil, bits(1, 2)},
+				"b": {bits(0)},
+			},
+			attesters: map[string]map[phase0.ValidatorIndex]uint64{
+				"a": {100: 0},
+			},
+		},
+		"wronghead": {
+			beaconBlockRoot: orphan,
+			targetRoot:      target,
+			buckets: map[string][]bitfield.Bitlist{
+				"a": {bits(3)},
+			},
+		},
+		"wrongtarget": {
+			beaconBlockRoot: head,
+			targetRoot:      other,
+			buckets: map[string][]bitfield.Bitlist{
+				"a": {bits(4, 5)},
+			},
+		},
+	}
+
+	correctness := s.voteCorrectness(40, summaries)
+	require.Equal(t, map[string]*submitter.AttestationVoteCorrectness{
+		"a": {
+			Correct:     4,
+			WrongHead:   1,
+			WrongTarget: 2,
+		},
+	}, correctness)
+
+	// Pruning retains the latest head at or before the given slot.
+	s.pruneHeads(32)
+	require.Equal(t, map[phase0.Slot]phase0.Root{32: target, 37: head}, s.heads["a"])
+	s.pruneHeads(40)
+	require.Equal(t, map[phase0.Slot]phase0.Root{37: head}, s.heads["a"])
+}
+
+func TestHandleChainReorg(t *testing.T) {
+	s := &Service{
+		nodeStatus: mocknodestatus.New(),
+		heads:      make(map[string]map[phase0.Slot]phase0.Root),
+	}
+
+	ancestor := phase0.Root{0x01}
+	orphan1 := phase0.Root{0x02}
+	orphan2 := phase0.Root{0x03}
+	newHead := phase0.Root{0x04}
+
+	// Node a follows a branch of two blocks on top of the common ancestor,
+	// before reorging to a new head at the same slot as the old head.
+	s.handleHead("a", &apiv1.HeadEvent{Slot: 20, Block: ancestor})
+	s.handleHead("a", &apiv1.HeadEvent{Slot: 21, Block: orphan1})
+	s.handleHead("a", &apiv1.HeadEvent{Slot: 22, Block: orphan2})
+	// Node b only saw the first block of the branch.
+	s.handleHead("b", &apiv1.HeadEvent{Slot: 21, Block: orphan1})
+
+	s.handleChainReorg("a", &apiv1.ChainReorgEvent{Slot: 22, Depth: 2, OldHeadBlock: orphan2, NewHeadBlock: newHead})
+	s.handleHead("a", &apiv1.HeadEvent{Slot: 22, Block: newHead})
+
+	// The whole branch is removed from node a, but node b is unaffected.
+	require.Equal(t, map[phase0.Slot]phase0.Root{20: ancestor, 22: newHead}, s.heads["a"])
+	require.Equal(t, map[phase0.Slot]phase0.Root{21: orphan1}, s.heads["b"])
+}
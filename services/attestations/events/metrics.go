@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -19,12 +19,18 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/wealdtech/probec/services/metrics"
+	"github.com/wealdtech/probec/services/submitter"
 )
 
 var (
 	delayTimer      prometheus.Histogram
 	latestTimestamp prometheus.Gauge
 	eventsReceived  prometheus.Counter
+	eventsIgnored   prometheus.Counter
+	summarySlots    prometheus.Gauge
+	slotsEvicted    prometheus.Counter
+	resolutionFails prometheus.Counter
+	votes           *prometheus.CounterVec
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -84,8 +90,58 @@ func registerPrometheusMetrics(_ context.Context) error {
 		Name:      "events_total",
 		Help:      "The number of attestation events received.",
 	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "attestations",
+		Name:      "events_ignored_total",
+		Help:      "The number of attestation events ignored because their node was not in a submittable state.",
+	})
+	if err := prometheus.Register(eventsIgnored); err != nil {
+		return err
+	}
+
+	summarySlots = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "attestations",
+		Name:      "summary_slots",
+		Help:      "The number of slots for which attestation summaries are held.",
+	})
+	if err := prometheus.Register(summarySlots); err != nil {
+		return err
+	}
+
+	slotsEvicted = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "attestations",
+		Name:      "slots_evicted_total",
+		Help:      "The number of slots for which attestation summaries were evicted without being submitted.",
+	})
+	if err := prometheus.Register(slotsEvicted); err != nil {
+		return err
+	}
+
+	resolutionFails = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "attestations",
+		Name:      "resolution_failures_total",
+		Help:      "The number of attestation summaries and aggregates whose attesters could not be resolved.",
+	})
+	if err := prometheus.Register(resolutionFails); err != nil {
+		return err
+	}
+
+	votes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "attestations",
+		Name:      "votes_total",
+		Help:      "The number of attesters seen by each node, by the correctness of their votes against its view of the chain.",
+	}, []string{"node", "outcome"})
 
-	return prometheus.Register(eventsReceived)
+	return prometheus.Register(votes)
 }
 
 // monitorEventSeen is called when a block event has been seen.
@@ -98,3 +154,50 @@ func monitorEventProcessed(delay time.Duration) {
 	eventsReceived.Inc()
 	delayTimer.Observe(delay.Seconds())
 }
+
+// monitorEventIgnored is called when a attestation event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
+
+// monitorSummarySlots is called when the number of slots for which summaries are held changes.
+func monitorSummarySlots(slots int) {
+	if summarySlots == nil {
+		return
+	}
+
+	summarySlots.Set(float64(slots))
+}
+
+// monitorSlotsEvicted is called when summaries for stale slots have been evicted.
+func monitorSlotsEvicted(slots int) {
+	if slotsEvicted == nil {
+		return
+	}
+
+	slotsEvicted.Add(float64(slots))
+}
+
+// monitorResolutionFailed is called when the attesters of a summary could not be resolved.
+func monitorResolutionFailed() {
+	if resolutionFails == nil {
+		return
+	}
+
+	resolutionFails.Inc()
+}
+
+// monitorVoteCorrectness is called when the votes for a slot have been checked against a node's view of the chain.
+func monitorVoteCorrectness(address string, correctness *submitter.AttestationVoteCorrectness) {
+	if votes == nil {
+		return
+	}
+
+	votes.WithLabelValues(address, "correct").Add(float64(correctness.Correct))
+	votes.WithLabelValues(address, "wrong_head").Add(float64(correctness.WrongHead))
+	votes.WithLabelValues(address, "wrong_target").Add(float64(correctness.WrongTarget))
+}
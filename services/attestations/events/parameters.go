@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,22 +15,32 @@ package events
 
 import (
 	"errors"
+	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/rs/zerolog"
 	"github.com/wealdtech/probec/services/chaintime"
 	"github.com/wealdtech/probec/services/metrics"
 	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
 	"github.com/wealdtech/probec/services/submitter"
 )
 
 type parameters struct {
-	logLevel             zerolog.Level
-	monitor              metrics.Service
-	chainTime            chaintime.Service
-	eventsProviders      map[string]consensusclient.EventsProvider
-	nodeVersionProviders map[string]consensusclient.NodeVersionProvider
-	submitter            submitter.Service
+	logLevel                 zerolog.Level
+	monitor                  metrics.Service
+	chainTime                chaintime.Service
+	eventsProviders          map[string]consensusclient.EventsProvider
+	nodeStatus               nodestatus.Service
+	submitter                submitter.Service
+	streams                  streams.Service
+	bucketWidth              time.Duration
+	horizon                  time.Duration
+	flushOffset              time.Duration
+	beaconCommitteesProvider consensusclient.BeaconCommitteesProvider
+	validatorIndices         []phase0.ValidatorIndex
 }
 
 // Parameter is the interface for service parameters.
@@ -72,10 +82,10 @@ func WithEventsProviders(providers map[string]consensusclient.EventsProvider) Pa
 	})
 }
 
-// WithNodeVersionProviders sets the node version providers for this module.
-func WithNodeVersionProviders(providers map[string]consensusclient.NodeVersionProvider) Parameter {
+// WithNodeStatus sets the node status service for this module.
+func WithNodeStatus(service nodestatus.Service) Parameter {
 	return parameterFunc(func(p *parameters) {
-		p.nodeVersionProviders = providers
+		p.nodeStatus = service
 	})
 }
 
@@ -86,11 +96,58 @@ func WithSubmitter(submitter submitter.Service) Parameter {
 	})
 }
 
+// WithStreams sets the streams service for this module.
+func WithStreams(service streams.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.streams = service
+	})
+}
+
+// WithBucketWidth sets the width of the time buckets into which attestations are placed.
+func WithBucketWidth(width time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bucketWidth = width
+	})
+}
+
+// WithHorizon sets the time after the start of a slot beyond which attestations are ignored.
+// If not supplied, or 0, this is the duration of a slot.
+func WithHorizon(horizon time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.horizon = horizon
+	})
+}
+
+// WithFlushOffset sets the time after the end of a slot at which its attestation summary is submitted.
+func WithFlushOffset(offset time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.flushOffset = offset
+	})
+}
+
+// WithBeaconCommitteesProvider sets the beacon committees provider for this module.
+// If supplied, aggregation bits are resolved to the indices of the attesting validators.
+func WithBeaconCommitteesProvider(provider consensusclient.BeaconCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteesProvider = provider
+	})
+}
+
+// WithValidatorIndices restricts the attestations reported to those from the given validators.
+// If not supplied, or empty, attestations from all validators are reported.
+func WithValidatorIndices(indices []phase0.ValidatorIndex) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorIndices = indices
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		monitor:  nullmetrics.New(),
+		logLevel:    zerolog.GlobalLevel(),
+		monitor:     nullmetrics.New(),
+		bucketWidth: 100 * time.Millisecond,
+		flushOffset: time.Second,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -107,12 +164,34 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if len(parameters.eventsProviders) == 0 {
 		return nil, errors.New("events providers not supplied")
 	}
-	if len(parameters.nodeVersionProviders) == 0 {
-		return nil, errors.New("node version providers not supplied")
+	if parameters.nodeStatus == nil {
+		return nil, errors.New("node status service not supplied")
 	}
 	if parameters.submitter == nil {
 		return nil, errors.New("submitter not supplied")
 	}
+	if parameters.streams == nil {
+		return nil, errors.New("streams service not supplied")
+	}
+	if parameters.bucketWidth <= 0 {
+		return nil, errors.New("bucket width must be greater than 0")
+	}
+	// Bucket widths are submitted in milliseconds.
+	if parameters.bucketWidth < time.Millisecond {
+		return nil, errors.New("bucket width must be at least 1ms")
+	}
+	if parameters.bucketWidth%time.Millisecond != 0 {
+		return nil, errors.New("bucket width must be a whole number of milliseconds")
+	}
+	if parameters.horizon < 0 {
+		return nil, errors.New("horizon cannot be negative")
+	}
+	if parameters.flushOffset < 0 {
+		return nil, errors.New("flush offset cannot be negative")
+	}
+	if len(parameters.validatorIndices) > 0 && parameters.beaconCommitteesProvider == nil {
+		return nil, errors.New("beacon committees provider required to restrict validators")
+	}
 
 	return &parameters, nil
 }
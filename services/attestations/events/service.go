@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -16,19 +16,22 @@ package events
 import (
 	"context"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	bitfield "github.com/prysmaticlabs/go-bitfield"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
 	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
 	"github.com/wealdtech/probec/services/submitter"
 )
 
@@ -38,15 +41,38 @@ type attestationSummary struct {
 	beaconBlockRoot phase0.Root
 	sourceRoot      phase0.Root
 	targetRoot      phase0.Root
-	buckets         map[string]*[120]bitfield.Bitlist
+	buckets         map[string][]bitfield.Bitlist
+	attesters       map[string]map[phase0.ValidatorIndex]uint64
+	// resolved is true if the aggregation bits in the buckets have been
+	// resolved to validator indices and added to the attesters.
+	resolved bool
 }
 
+// farFutureEpoch is the epoch used by chain time for forks that are not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
 // Service is an attestations tarcker service.
 type Service struct {
 	chainTime            chaintime.Service
 	submitter            submitter.Service
+	streams              streams.Service
+	nodeStatus           nodestatus.Service
+	bucketWidth          time.Duration
+	horizon              time.Duration
+	flushOffset          time.Duration
+	numBuckets           int
 	attestationsMu       sync.Mutex
 	attestationSummaries map[phase0.Slot]map[string]*attestationSummary
+
+	// heads are the blocks that each node has seen as its head, keyed by address and slot.
+	headsMu sync.Mutex
+	heads   map[string]map[phase0.Slot]phase0.Root
+
+	beaconCommitteesProvider consensusclient.BeaconCommitteesProvider
+	// validators are the validators to which reporting is restricted; nil if not restricted.
+	validators       map[phase0.ValidatorIndex]struct{}
+	committeesMu     sync.Mutex
+	beaconCommittees map[phase0.Epoch]*epochCommittees
 }
 
 // module-wide log.
@@ -72,56 +98,70 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	s := &Service{
 		chainTime:            parameters.chainTime,
 		submitter:            parameters.submitter,
+		streams:              parameters.streams,
+		nodeStatus:           parameters.nodeStatus,
+		bucketWidth:          parameters.bucketWidth,
+		horizon:              parameters.horizon,
+		flushOffset:          parameters.flushOffset,
 		attestationSummaries: make(map[phase0.Slot]map[string]*attestationSummary),
+		heads:                make(map[string]map[phase0.Slot]phase0.Root),
+
+		beaconCommitteesProvider: parameters.beaconCommitteesProvider,
+		beaconCommittees:         make(map[phase0.Epoch]*epochCommittees),
+	}
+	if len(parameters.validatorIndices) > 0 {
+		s.validators = make(map[phase0.ValidatorIndex]struct{}, len(parameters.validatorIndices))
+		for _, index := range parameters.validatorIndices {
+			s.validators[index] = struct{}{}
+		}
+	}
+	if s.horizon == 0 {
+		s.horizon = s.chainTime.SlotDuration()
+	}
+	// The final bucket may be partial if the horizon is not a multiple of the bucket width.
+	s.numBuckets = int((s.horizon + s.bucketWidth - 1) / s.bucketWidth)
+	// Attestations accepted after a slot has been flushed would be lost.
+	if s.horizon > s.chainTime.SlotDuration()+s.flushOffset {
+		return nil, errors.New("horizon cannot extend beyond the flush of the slot")
 	}
 
 	for address, eventsProvider := range parameters.eventsProviders {
-		if err := s.monitorEvents(ctx, address, eventsProvider, parameters.nodeVersionProviders[address]); err != nil {
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
 			return nil, err
 		}
 	}
 
+	go s.flushSummaries(ctx)
+
 	return s, nil
 }
 
 func (s *Service) monitorEvents(ctx context.Context,
 	address string,
 	eventsProvider consensusclient.EventsProvider,
-	nodeVersionProvider consensusclient.NodeVersionProvider,
 ) error {
-	if err := eventsProvider.Events(ctx, &api.EventsOpts{
-		Topics: []string{"attestation"},
-		AttestationHandler: func(ctx context.Context, event *spec.VersionedAttestation) {
-			data, err := event.Data()
-			if err != nil {
-				log.Error().Err(err).Msg("Failed to get attestation data")
-				return
-			}
-
-			delay := time.Since(s.chainTime.StartOfSlot(data.Slot))
-			if delay.Seconds() < 0 || delay.Seconds() > 12 {
-				log.Trace().Uint64("slot", uint64(data.Slot)).Stringer("delay", delay).Msg("Delay out of range, ignoring")
-				return
-			}
-			monitorEventProcessed(delay)
-
-			// We treat attestations differently depending on if they are individual or aggregate.
-			aggregationBits, err := event.AggregationBits()
-			if err != nil {
-				log.Error().Err(err).Msg("Failed to get attestation aggregation bits")
-				return
-			}
-			attestation := &phase0.Attestation{
-				AggregationBits: aggregationBits,
-				Data:            data,
-			}
+	// Head and chain reorg events provide the node's view of the chain,
+	// against which votes are checked.
+	topics := []string{"attestation", "head", "chain_reorg"}
+	// Nodes reject subscriptions to topics they do not know, so only
+	// subscribe to single attestations if the chain has scheduled Electra.
+	if s.chainTime.ElectraInitialEpoch() != farFutureEpoch {
+		topics = append(topics, "single_attestation")
+	}
 
-			validators := aggregationBits.Count()
-			if validators == 1 {
-				s.handleAttestation(ctx, address, attestation, delay)
-			} else {
-				s.handleAggregateAttestation(ctx, nodeVersionProvider, attestation, delay)
-			}
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: topics,
+		AttestationHandler: func(ctx context.Context, event *spec.VersionedAttestation) {
+			s.handleVersionedAttestation(ctx, address, event)
+		},
+		SingleAttestationHandler: func(_ context.Context, event *electra.SingleAttestation) {
+			s.handleSingleAttestation(address, event)
+		},
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			s.handleHead(address, event)
+		},
+		ChainReorgHandler: func(_ context.Context, event *apiv1.ChainReorgEvent) {
+			s.handleChainReorg(address, event)
 		},
 	}); err != nil {
 		return errors.Wrap(err, "failed to create events provider")
@@ -130,135 +170,318 @@ func (s *Service) monitorEvents(ctx context.Context,
 	return nil
 }
 
-func (s *Service) handleAttestation(ctx context.Context,
+func (s *Service) handleVersionedAttestation(ctx context.Context,
 	address string,
-	attestation *phase0.Attestation,
+	event *spec.VersionedAttestation,
+) {
+	data, err := event.Data()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get attestation data")
+		return
+	}
+
+	delay, accepted := s.acceptAttestation(address, data)
+	if !accepted {
+		return
+	}
+
+	aggregationBits, err := event.AggregationBits()
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to get attestation aggregation bits")
+		return
+	}
+
+	// From Electra the committee index in the attestation data is always 0,
+	// and the committees are instead defined by the committee bits.
+	committeeIndices := []phase0.CommitteeIndex{data.Index}
+	if event.Version >= spec.DataVersionElectra {
+		committeeBits, err := event.CommitteeBits()
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get attestation committee bits")
+			return
+		}
+		bitIndices := committeeBits.BitIndices()
+		if len(bitIndices) == 0 {
+			log.Debug().Uint64("slot", uint64(data.Slot)).Msg("Attestation has no committee bits set; ignoring")
+			return
+		}
+		committeeIndices = make([]phase0.CommitteeIndex, len(bitIndices))
+		for i := range bitIndices {
+			committeeIndices[i] = phase0.CommitteeIndex(bitIndices[i])
+		}
+	}
+
+	// We treat attestations differently depending on if they are individual or aggregate.
+	if len(committeeIndices) == 1 && aggregationBits.Count() == 1 {
+		s.handleAttestation(address, data, committeeIndices[0], aggregationBits, 0, delay)
+	} else if s.validators != nil {
+		// Restricting to our validators can require fetching committees, so do not hold up the stream.
+		go s.handleAggregateAttestation(ctx, address, data, committeeIndices, aggregationBits, delay)
+	} else {
+		s.handleAggregateAttestation(ctx, address, data, committeeIndices, aggregationBits, delay)
+	}
+}
+
+func (s *Service) handleSingleAttestation(address string, event *electra.SingleAttestation) {
+	if event.Data == nil {
+		log.Debug().Msg("Single attestation event without data; ignoring")
+		return
+	}
+
+	delay, accepted := s.acceptAttestation(address, event.Data)
+	if !accepted {
+		return
+	}
+
+	s.handleAttestation(address, event.Data, event.CommitteeIndex, nil, event.AttesterIndex, delay)
+}
+
+// acceptAttestation returns the delay of the attestation, and if it should be processed.
+func (s *Service) acceptAttestation(address string,
+	data *phase0.AttestationData,
+) (
+	time.Duration,
+	bool,
+) {
+	delay := time.Since(s.chainTime.StartOfSlot(data.Slot))
+	if delay < 0 || delay >= s.horizon {
+		log.Trace().Uint64("slot", uint64(data.Slot)).Stringer("delay", delay).Msg("Delay out of range, ignoring")
+		return 0, false
+	}
+
+	// Ensure the node is in a state to provide useful information.
+	if !s.nodeStatus.Submittable(address) {
+		log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+		monitorEventIgnored()
+		return 0, false
+	}
+
+	monitorEventProcessed(delay)
+
+	return delay, true
+}
+
+// handleAttestation handles an individual attestation.  Attestations from the
+// attestation topic are identified by their aggregation bits, and single
+// attestations, which have no aggregation bits, by their attester index.
+func (s *Service) handleAttestation(address string,
+	attestationData *phase0.AttestationData,
+	committeeIndex phase0.CommitteeIndex,
+	aggregationBits bitfield.Bitlist,
+	attesterIndex phase0.ValidatorIndex,
 	delay time.Duration,
 ) {
-	bucket := delay.Milliseconds() % 100
-	if bucket < 0 || bucket > 119 {
-		log.Debug().Int64("bucket", bucket).Msg("Bucket out of range; ignoring")
+	bucket, exists := s.bucket(delay)
+	if !exists {
+		log.Debug().Stringer("delay", delay).Msg("Bucket out of range; ignoring")
 		return
 	}
 
 	key := fmt.Sprintf("%d:%x:%x:%x",
-		attestation.Data.Index,
-		attestation.Data.BeaconBlockRoot,
-		attestation.Data.Source.Root,
-		attestation.Data.Target.Root,
+		committeeIndex,
+		attestationData.BeaconBlockRoot,
+		attestationData.Source.Root,
+		attestationData.Target.Root,
 	)
 	s.attestationsMu.Lock()
-	slotSummaries, exists := s.attestationSummaries[attestation.Data.Slot]
+	defer s.attestationsMu.Unlock()
+	slotSummaries, exists := s.attestationSummaries[attestationData.Slot]
 	if !exists {
 		slotSummaries = make(map[string]*attestationSummary)
-		s.attestationSummaries[attestation.Data.Slot] = slotSummaries
+		s.attestationSummaries[attestationData.Slot] = slotSummaries
+		monitorSummarySlots(len(s.attestationSummaries))
 	}
 	summary, exists := slotSummaries[key]
 	if !exists {
 		summary = &attestationSummary{
-			committee:       attestation.Data.Index,
-			beaconBlockRoot: attestation.Data.BeaconBlockRoot,
-			sourceRoot:      attestation.Data.Source.Root,
-			targetRoot:      attestation.Data.Target.Root,
-			buckets:         map[string]*[120]bitfield.Bitlist{},
+			committee:       committeeIndex,
+			beaconBlockRoot: attestationData.BeaconBlockRoot,
+			sourceRoot:      attestationData.Source.Root,
+			targetRoot:      attestationData.Target.Root,
+			buckets:         map[string][]bitfield.Bitlist{},
+			attesters:       map[string]map[phase0.ValidatorIndex]uint64{},
 		}
 		slotSummaries[key] = summary
 	}
-	buckets, exists := summary.buckets[address]
-	if !exists {
-		buckets = &[120]bitfield.Bitlist{}
-		summary.buckets[address] = buckets
-	}
-	if buckets[bucket] == nil {
-		buckets[bucket] = attestation.AggregationBits
+	if aggregationBits == nil {
+		attesters, exists := summary.attesters[address]
+		if !exists {
+			attesters = make(map[phase0.ValidatorIndex]uint64)
+			summary.attesters[address] = attesters
+		}
+		if _, exists := attesters[attesterIndex]; !exists {
+			attesters[attesterIndex] = uint64(bucket)
+		}
 	} else {
-		var err error
-		buckets[bucket], err = buckets[bucket].Or(attestation.AggregationBits)
-		if err != nil {
-			s.attestationsMu.Unlock()
-			log.Error().Err(err).Msg("Failed to aggregate attestations")
+		buckets, exists := summary.buckets[address]
+		if !exists {
+			buckets = make([]bitfield.Bitlist, s.numBuckets)
+			summary.buckets[address] = buckets
+		}
+		if buckets[bucket] == nil {
+			buckets[bucket] = aggregationBits
+		} else {
+			var err error
+			buckets[bucket], err = buckets[bucket].Or(aggregationBits)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to aggregate attestations")
+				return
+			}
+		}
+	}
+}
+
+// flushSummaries submits the summary for each slot once the flush offset
+// after the end of the slot has passed.
+func (s *Service) flushSummaries(ctx context.Context) {
+	for {
+		slot := s.chainTime.TimestampToSlot(time.Now().Add(-s.flushOffset))
+		timer := time.NewTimer(time.Until(s.chainTime.StartOfSlot(slot + 1).Add(s.flushOffset)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
 			return
+		case <-timer.C:
 		}
+
+		s.flushSlot(ctx, slot)
 	}
+}
+
+// flushSlot submits and removes the summary for the given slot, and evicts
+// any summaries for earlier slots.
+func (s *Service) flushSlot(ctx context.Context, slot phase0.Slot) {
+	s.attestationsMu.Lock()
+	slotSummaries, exists := s.attestationSummaries[slot]
+	delete(s.attestationSummaries, slot)
+	evicted := 0
+	for summarySlot := range s.attestationSummaries {
+		if summarySlot < slot {
+			delete(s.attestationSummaries, summarySlot)
+			evicted++
+		}
+	}
+	monitorSummarySlots(len(s.attestationSummaries))
+	s.attestationsMu.Unlock()
+
+	// Heads are kept for as long as they may be needed as the target of a vote.
+	defer s.pruneHeads(s.chainTime.FirstSlotOfEpoch(s.chainTime.SlotToEpoch(slot)))
 
-	lastSlotSummaries, exists := s.attestationSummaries[attestation.Data.Slot-1]
+	if evicted > 0 {
+		log.Debug().Uint64("slot", uint64(slot)).Int("evicted", evicted).Msg("Evicted stale attestation summaries")
+		monitorSlotsEvicted(evicted)
+	}
 	if !exists {
-		s.attestationsMu.Unlock()
 		return
 	}
 
-	delete(s.attestationSummaries, attestation.Data.Slot-1)
-	s.attestationsMu.Unlock()
-
-	// Build and send the data.
-	builder := strings.Builder{}
-	builder.WriteString(fmt.Sprintf(`{"method":"attestation event","slot":"%d","attestations":[`, attestation.Data.Slot-1))
-	firstSummary := true
-	for _, summary := range lastSlotSummaries {
-		if firstSummary {
-			firstSummary = false
-		} else {
-			builder.WriteString(",")
-		}
-		builder.WriteString(
-			fmt.Sprintf(`{"committee_index":"%d","beacon_block_root":"%#x","source_root":"%#x","target_root":"%#x","buckets":`,
-				summary.committee,
-				summary.beaconBlockRoot,
-				summary.sourceRoot,
-				summary.targetRoot,
-			),
-		)
-		builder.WriteString(`{`)
-		firstSource := true
-		for source, sourceBuckets := range summary.buckets {
-			if firstSource {
-				firstSource = false
-			} else {
-				builder.WriteString(",")
-			}
-			builder.WriteString(fmt.Sprintf(`"%s":[`, source))
-			firstBucket := true
-			for _, sourceBucket := range sourceBuckets {
-				if firstBucket {
-					firstBucket = false
-				} else {
-					builder.WriteString(",")
+	if s.beaconCommitteesProvider != nil {
+		for key, summary := range slotSummaries {
+			if err := s.resolveAttesters(ctx, slot, summary); err != nil {
+				monitorResolutionFailed()
+				if s.validators != nil {
+					// The summary cannot be restricted without its attesters,
+					// and its buckets would reveal the votes of other
+					// validators, so it is dropped.
+					log.Warn().
+						Uint64("slot", uint64(slot)).
+						Uint64("committee", uint64(summary.committee)).
+						Err(err).
+						Msg("Failed to resolve attesters; dropping summary")
+					delete(slotSummaries, key)
+					continue
 				}
-				builder.WriteString(fmt.Sprintf(`"%#x"`, sourceBucket))
+				// Without restriction the buckets carry the votes, so the
+				// summary is submitted unresolved rather than dropped.
+				log.Warn().
+					Uint64("slot", uint64(slot)).
+					Uint64("committee", uint64(summary.committee)).
+					Err(err).
+					Msg("Failed to resolve attesters; submitting unresolved summary")
+				continue
+			}
+			if s.validators != nil && !s.restrictAttesters(summary) {
+				delete(slotSummaries, key)
 			}
-			builder.WriteString(`]`)
 		}
-		builder.WriteString(`}}`)
 	}
-	builder.WriteString("]}")
-	log.Trace().RawJSON("data", []byte(builder.String())).Msg("Attestation summary")
 
-	s.submitter.SubmitAttestationSummary(ctx, builder.String())
+	// Build and send the data.
+	data := &submitter.AttestationSummary{
+		SchemaVersion: submitter.AttestationSummarySchemaVersion,
+		Method:        "attestation event",
+		Slot:          slot,
+		BucketWidth:   s.bucketWidth,
+		Attestations:  make([]*submitter.AttestationVoteSummary, 0, len(slotSummaries)),
+	}
+	for _, summary := range slotSummaries {
+		data.Attestations = append(data.Attestations, &submitter.AttestationVoteSummary{
+			CommitteeIndex:  summary.committee,
+			BeaconBlockRoot: summary.beaconBlockRoot,
+			SourceRoot:      summary.sourceRoot,
+			TargetRoot:      summary.targetRoot,
+			Buckets:         summary.buckets,
+			Attesters:       summary.attesters,
+		})
+	}
+	data.Correctness = s.voteCorrectness(slot, slotSummaries)
+	for address, correctness := range data.Correctness {
+		monitorVoteCorrectness(address, correctness)
+	}
+	log.Trace().Stringer("data", data).Msg("Attestation summary")
+
+	s.submitter.SubmitAttestationSummary(ctx, data)
+}
+
+// bucket returns the index of the bucket for the given delay, and if the bucket exists.
+func (s *Service) bucket(delay time.Duration) (int, bool) {
+	if delay < 0 {
+		return 0, false
+	}
+	bucket := int(delay / s.bucketWidth)
+	if bucket >= s.numBuckets {
+		return 0, false
+	}
+
+	return bucket, true
 }
 
 func (s *Service) handleAggregateAttestation(ctx context.Context,
-	nodeVersionProvider consensusclient.NodeVersionProvider,
-	attestation *phase0.Attestation,
+	address string,
+	attestationData *phase0.AttestationData,
+	committeeIndices []phase0.CommitteeIndex,
+	aggregationBits bitfield.Bitlist,
 	delay time.Duration,
 ) {
-	nodeVersionResponse, err := nodeVersionProvider.NodeVersion(ctx, &api.NodeVersionOpts{})
-	if err != nil {
-		log.Error().Err(err).Msg("Failed to obtain node version")
-		return
+	if s.validators != nil {
+		indices, err := s.attestingIndices(ctx, attestationData.Slot, committeeIndices, aggregationBits)
+		if err != nil {
+			// The aggregate cannot be restricted without its attesters, and
+			// would reveal the votes of other validators, so it is dropped.
+			log.Warn().Err(err).Uint64("slot", uint64(attestationData.Slot)).Msg("Failed to obtain attesting indices; dropping aggregate")
+			monitorResolutionFailed()
+			return
+		}
+		if !s.includesValidator(indices) {
+			return
+		}
 	}
 
 	// Build and send the data.
-	body := fmt.Sprintf(
-		`{"source":"%s","method":"attestation event","slot":"%d","committee_index":"%d","beacon_block_root":"%#x","source_root":"%#x","target_root":"%#x","aggregation_bits":"%#x","delay_ms":"%d"}`,
-		nodeVersionResponse.Data,
-		attestation.Data.Slot,
-		attestation.Data.Index,
-		attestation.Data.BeaconBlockRoot,
-		attestation.Data.Source.Root,
-		attestation.Data.Target.Root,
-		attestation.AggregationBits,
-		int(delay.Milliseconds()),
-	)
-	log.Trace().RawJSON("data", []byte(body)).Msg("Aggregate attestation")
-	s.submitter.SubmitAggregateAttestation(ctx, body)
+	data := &submitter.AggregateAttestation{
+		Source:          s.nodeStatus.Status(address).Version,
+		Method:          "attestation event",
+		Slot:            attestationData.Slot,
+		CommitteeIndex:  committeeIndices[0],
+		BeaconBlockRoot: attestationData.BeaconBlockRoot,
+		SourceRoot:      attestationData.Source.Root,
+		TargetRoot:      attestationData.Target.Root,
+		AggregationBits: aggregationBits,
+		Delay:           delay,
+	}
+	if len(committeeIndices) > 1 {
+		data.CommitteeIndices = committeeIndices
+	}
+	log.Trace().Stringer("data", data).Msg("Aggregate attestation")
+	s.submitter.SubmitAggregateAttestation(ctx, data)
 }
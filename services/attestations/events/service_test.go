@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -16,13 +16,17 @@ package events_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
-	"github.com/wealdtech/probec/services/blocks/events"
+	"github.com/wealdtech/probec/services/attestations/events"
 	standardchaintime "github.com/wealdtech/probec/services/chaintime/standard"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	mockstreams "github.com/wealdtech/probec/services/streams/mock"
 	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
 )
 
@@ -40,6 +44,8 @@ func TestService(t *testing.T) {
 	require.NoError(t, err)
 
 	submitter := mocksubmitter.New()
+	nodeStatus := mocknodestatus.New()
+	streams := mockstreams.New()
 
 	tests := []struct {
 		name   string
@@ -55,10 +61,9 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
-					"test": mockClient,
-				}),
+				events.WithNodeStatus(nodeStatus),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
 			},
 			err: "problem with parameters: monitor not supplied",
 		},
@@ -69,10 +74,9 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
-					"test": mockClient,
-				}),
+				events.WithNodeStatus(nodeStatus),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
 			},
 			err: "problem with parameters: chain time service not supplied",
 		},
@@ -82,15 +86,14 @@ func TestService(t *testing.T) {
 				events.WithLogLevel(zerolog.Disabled),
 				events.WithChainTime(chainTime),
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
-					"test": mockClient,
-				}),
+				events.WithNodeStatus(nodeStatus),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
 			},
 			err: "problem with parameters: events providers not supplied",
 		},
 		{
-			name: "NodeVersionProvidersMissing",
+			name: "NodeStatusMissing",
 			params: []events.Parameter{
 				events.WithLogLevel(zerolog.Disabled),
 				events.WithChainTime(chainTime),
@@ -98,8 +101,9 @@ func TestService(t *testing.T) {
 					"test": mockClient,
 				}),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
 			},
-			err: "problem with parameters: node version providers not supplied",
+			err: "problem with parameters: node status service not supplied",
 		},
 		{
 			name: "SubmitterMissing",
@@ -109,11 +113,129 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
+				events.WithNodeStatus(nodeStatus),
+				events.WithStreams(streams),
+			},
+			err: "problem with parameters: submitter not supplied",
+		},
+		{
+			name: "StreamsMissing",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
 			},
-			err: "problem with parameters: submitter not supplied",
+			err: "problem with parameters: streams service not supplied",
+		},
+		{
+			name: "BucketWidthZero",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithBucketWidth(0),
+			},
+			err: "problem with parameters: bucket width must be greater than 0",
+		},
+		{
+			name: "BucketWidthSubMillisecond",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithBucketWidth(500 * time.Microsecond),
+			},
+			err: "problem with parameters: bucket width must be at least 1ms",
+		},
+		{
+			name: "BucketWidthFractionalMilliseconds",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithBucketWidth(1500 * time.Microsecond),
+			},
+			err: "problem with parameters: bucket width must be a whole number of milliseconds",
+		},
+		{
+			name: "HorizonNegative",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithHorizon(-time.Second),
+			},
+			err: "problem with parameters: horizon cannot be negative",
+		},
+		{
+			name: "FlushOffsetNegative",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithFlushOffset(-time.Second),
+			},
+			err: "problem with parameters: flush offset cannot be negative",
+		},
+		{
+			name: "ValidatorIndicesWithoutCommittees",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithValidatorIndices([]phase0.ValidatorIndex{1, 2}),
+			},
+			err: "problem with parameters: beacon committees provider required to restrict validators",
+		},
+		{
+			name: "HorizonBeyondFlush",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithHorizon(time.Minute),
+				events.WithFlushOffset(time.Second),
+			},
+			err: "horizon cannot extend beyond the flush of the slot",
 		},
 		{
 			name: "Good",
@@ -123,10 +245,24 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+			},
+		},
+		{
+			name: "GoodValidatorIndices",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
+				events.WithNodeStatus(nodeStatus),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithBeaconCommitteesProvider(mockClient),
+				events.WithValidatorIndices([]phase0.ValidatorIndex{1, 2}),
 			},
 		},
 	}
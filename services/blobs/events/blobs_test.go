@@ -0,0 +1,171 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	mockchaintime "github.com/wealdtech/probec/services/chaintime/mock"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	"github.com/wealdtech/probec/services/submitter"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+func newTestService(t *testing.T) (*Service, *mocksubmitter.Recorder) {
+	t.Helper()
+
+	chainTime, err := mockchaintime.NewStandard(time.Now().Add(-time.Hour), 12*time.Second)
+	require.NoError(t, err)
+
+	recorder := mocksubmitter.NewRecorder()
+
+	return &Service{
+		chainTime:  chainTime,
+		submitter:  recorder,
+		nodeStatus: mocknodestatus.New(),
+		timings:    make(map[phase0.Slot]map[string]*blockTiming),
+	}, recorder
+}
+
+func TestSummary(t *testing.T) {
+	s, _ := newTestService(t)
+
+	root := phase0.Root{0x01}
+
+	tests := []struct {
+		name     string
+		timing   *blockTiming
+		expected *submitter.BlobDelaySummary
+	}{
+		{
+			name: "BlockSeen",
+			timing: &blockTiming{
+				address:    "a",
+				root:       root,
+				blockSeen:  true,
+				blockDelay: 2 * time.Second,
+				blobs: map[uint64]time.Duration{
+					1: 3 * time.Second,
+					0: 2500 * time.Millisecond,
+				},
+			},
+			expected: &submitter.BlobDelaySummary{
+				Source:     "mock",
+				Method:     "blob sidecar event",
+				Slot:       10,
+				BlockRoot:  root,
+				BlockSeen:  true,
+				BlockDelay: 2 * time.Second,
+				Blobs: []*submitter.BlobDelay{
+					{Index: 0, Delay: 2500 * time.Millisecond, SinceBlock: 500 * time.Millisecond},
+					{Index: 1, Delay: 3 * time.Second, SinceBlock: time.Second},
+				},
+			},
+		},
+		{
+			name: "BlobBeforeBlock",
+			timing: &blockTiming{
+				address:    "a",
+				root:       root,
+				blockSeen:  true,
+				blockDelay: 2 * time.Second,
+				blobs: map[uint64]time.Duration{
+					0: 1500 * time.Millisecond,
+				},
+			},
+			expected: &submitter.BlobDelaySummary{
+				Source:     "mock",
+				Method:     "blob sidecar event",
+				Slot:       10,
+				BlockRoot:  root,
+				BlockSeen:  true,
+				BlockDelay: 2 * time.Second,
+				Blobs: []*submitter.BlobDelay{
+					{Index: 0, Delay: 1500 * time.Millisecond, SinceBlock: -500 * time.Millisecond},
+				},
+			},
+		},
+		{
+			name: "BlockNotSeen",
+			timing: &blockTiming{
+				address: "a",
+				root:    root,
+				blobs: map[uint64]time.Duration{
+					0: 5 * time.Second,
+				},
+			},
+			expected: &submitter.BlobDelaySummary{
+				Source:    "mock",
+				Method:    "blob sidecar event",
+				Slot:      10,
+				BlockRoot: root,
+				Blobs: []*submitter.BlobDelay{
+					{Index: 0, Delay: 5 * time.Second},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, s.summary(10, test.timing))
+		})
+	}
+}
+
+func TestSubmitSummariesBefore(t *testing.T) {
+	ctx := context.Background()
+	s, recorder := newTestService(t)
+
+	slot := s.chainTime.CurrentSlot() - 5
+	root := phase0.Root{0x01}
+
+	// A block with blobs on one node, and only the block on another.
+	s.handleBlock("a", &apiv1.BlockEvent{Slot: slot, Block: root})
+	s.handleBlobSidecar("a", &apiv1.BlobSidecarEvent{Slot: slot, BlockRoot: root, Index: deneb.BlobIndex(0)})
+	s.handleBlock("b", &apiv1.BlockEvent{Slot: slot, Block: root})
+
+	// A blob arriving for a later slot, before its block.
+	s.handleBlobSidecar("a", &apiv1.BlobSidecarEvent{Slot: slot + 1, BlockRoot: phase0.Root{0x02}, Index: deneb.BlobIndex(0)})
+
+	// Only the earlier slot is summarised, and the block without blobs is not submitted.
+	s.submitSummariesBefore(ctx, slot+1)
+	summaries := recorder.BlobDelaySummaries()
+	require.Len(t, summaries, 1)
+	summary := summaries[0]
+	require.Equal(t, slot, summary.Slot)
+	require.Equal(t, root, summary.BlockRoot)
+	require.True(t, summary.BlockSeen)
+	require.GreaterOrEqual(t, summary.BlockDelay, 5*s.chainTime.SlotDuration())
+	require.Len(t, summary.Blobs, 1)
+	require.GreaterOrEqual(t, summary.Blobs[0].Delay, summary.BlockDelay)
+	require.Equal(t, summary.Blobs[0].Delay-summary.BlockDelay, summary.Blobs[0].SinceBlock)
+	require.NotContains(t, s.timings, slot)
+
+	// The later slot is summarised, without a block.
+	s.submitSummariesBefore(ctx, slot+2)
+	summaries = recorder.BlobDelaySummaries()
+	require.Len(t, summaries, 2)
+	summary = summaries[1]
+	require.Equal(t, slot+1, summary.Slot)
+	require.False(t, summary.BlockSeen)
+	require.Zero(t, summary.Blobs[0].SinceBlock)
+	require.Empty(t, s.timings)
+}
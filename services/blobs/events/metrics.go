@@ -0,0 +1,122 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	delayTimer      prometheus.Histogram
+	sinceBlockTimer prometheus.Histogram
+	latestTimestamp prometheus.Gauge
+	eventsReceived  prometheus.Counter
+	eventsIgnored   prometheus.Counter
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if latestTimestamp != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	delayTimer = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "blobs",
+		Name:      "delay_seconds",
+		Help:      "The time from the start of the slot to receipt of the blob sidecar event.",
+		Buckets:   prometheus.LinearBuckets(0.1, 0.1, 120),
+	})
+	if err := prometheus.Register(delayTimer); err != nil {
+		return err
+	}
+
+	sinceBlockTimer = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "blobs",
+		Name:      "since_block_seconds",
+		Help:      "The time from receipt of the block event to receipt of the blob sidecar event.",
+		Buckets:   prometheus.LinearBuckets(-2, 0.1, 60),
+	})
+	if err := prometheus.Register(sinceBlockTimer); err != nil {
+		return err
+	}
+
+	latestTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "blobs",
+		Name:      "latest_timestamp",
+		Help:      "The latest timestamp at which probec obtained a blob sidecar event.",
+	})
+	if err := prometheus.Register(latestTimestamp); err != nil {
+		return err
+	}
+
+	eventsReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "blobs",
+		Name:      "events_total",
+		Help:      "The number of blob sidecar events received.",
+	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "blobs",
+		Name:      "events_ignored_total",
+		Help:      "The number of blob sidecar events ignored because their node was not in a submittable state.",
+	})
+
+	return prometheus.Register(eventsIgnored)
+}
+
+// monitorEventProcessed is called when a blob sidecar event has been processed.
+func monitorEventProcessed(delay time.Duration, blockSeen bool, sinceBlock time.Duration) {
+	if latestTimestamp == nil {
+		return
+	}
+
+	latestTimestamp.SetToCurrentTime()
+	eventsReceived.Inc()
+	delayTimer.Observe(delay.Seconds())
+	if blockSeen {
+		sinceBlockTimer.Observe(sinceBlock.Seconds())
+	}
+}
+
+// monitorEventIgnored is called when a blob sidecar event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
@@ -0,0 +1,244 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// blockTiming contains the arrival times of a block and its blob sidecars on a single node.
+type blockTiming struct {
+	address    string
+	root       phase0.Root
+	blockSeen  bool
+	blockDelay time.Duration
+	blobs      map[uint64]time.Duration
+}
+
+// Service is a blob sidecar timing service.
+type Service struct {
+	chainTime  chaintime.Service
+	submitter  submitter.Service
+	streams    streams.Service
+	nodeStatus nodestatus.Service
+
+	timingsMu sync.Mutex
+	timings   map[phase0.Slot]map[string]*blockTiming
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new blob sidecar timing service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "blobs").Str("impl", "events").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:  parameters.chainTime,
+		submitter:  parameters.submitter,
+		streams:    parameters.streams,
+		nodeStatus: parameters.nodeStatus,
+		timings:    make(map[phase0.Slot]map[string]*blockTiming),
+	}
+
+	for address, eventsProvider := range parameters.eventsProviders {
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	go s.submitSummaries(ctx)
+
+	return s, nil
+}
+
+func (s *Service) monitorEvents(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+) error {
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: []string{"block", "blob_sidecar"},
+		BlockHandler: func(_ context.Context, event *apiv1.BlockEvent) {
+			s.handleBlock(address, event)
+		},
+		BlobSidecarHandler: func(_ context.Context, event *apiv1.BlobSidecarEvent) {
+			s.handleBlobSidecar(address, event)
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create events provider")
+	}
+
+	return nil
+}
+
+func (s *Service) handleBlock(address string, event *apiv1.BlockEvent) {
+	delay := time.Since(s.chainTime.StartOfSlot(event.Slot))
+
+	s.timingsMu.Lock()
+	defer s.timingsMu.Unlock()
+
+	timing := s.timingLocked(address, event.Slot, event.Block)
+	timing.blockSeen = true
+	timing.blockDelay = delay
+}
+
+func (s *Service) handleBlobSidecar(address string, event *apiv1.BlobSidecarEvent) {
+	delay := time.Since(s.chainTime.StartOfSlot(event.Slot))
+
+	// Ensure the node is in a state to provide useful information.
+	if !s.nodeStatus.Submittable(address) {
+		log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+		monitorEventIgnored()
+		return
+	}
+
+	s.timingsMu.Lock()
+	timing := s.timingLocked(address, event.Slot, event.BlockRoot)
+	timing.blobs[uint64(event.Index)] = delay
+	blockSeen := timing.blockSeen
+	blockDelay := timing.blockDelay
+	s.timingsMu.Unlock()
+
+	log.Trace().
+		Str("address", address).
+		Uint64("slot", uint64(event.Slot)).
+		Uint64("index", uint64(event.Index)).
+		Stringer("delay", delay).
+		Msg("Received blob sidecar event")
+	monitorEventProcessed(delay, blockSeen, delay-blockDelay)
+}
+
+// timingLocked returns the timing for the given block on the given node,
+// creating it if required; the caller must hold the lock.
+func (s *Service) timingLocked(address string, slot phase0.Slot, root phase0.Root) *blockTiming {
+	slotTimings, exists := s.timings[slot]
+	if !exists {
+		slotTimings = make(map[string]*blockTiming)
+		s.timings[slot] = slotTimings
+	}
+	key := fmt.Sprintf("%s:%#x", address, root)
+	timing, exists := slotTimings[key]
+	if !exists {
+		timing = &blockTiming{
+			address: address,
+			root:    root,
+			blobs:   make(map[uint64]time.Duration),
+		}
+		slotTimings[key] = timing
+	}
+
+	return timing
+}
+
+// submitSummaries submits the summaries for each slot once its blob sidecars
+// have had a full slot in which to arrive.
+func (s *Service) submitSummaries(ctx context.Context) {
+	for {
+		timer := time.NewTimer(time.Until(s.chainTime.StartOfSlot(s.chainTime.CurrentSlot() + 1)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		currentSlot := s.chainTime.CurrentSlot()
+		if currentSlot < 2 {
+			continue
+		}
+		s.submitSummariesBefore(ctx, currentSlot-1)
+	}
+}
+
+// submitSummariesBefore submits and removes the summaries for slots before the given slot.
+func (s *Service) submitSummariesBefore(ctx context.Context, slot phase0.Slot) {
+	s.timingsMu.Lock()
+	summaries := make([]*submitter.BlobDelaySummary, 0)
+	for timingSlot, slotTimings := range s.timings {
+		if timingSlot >= slot {
+			continue
+		}
+		for _, timing := range slotTimings {
+			if len(timing.blobs) == 0 {
+				// Block without blobs.
+				continue
+			}
+			summaries = append(summaries, s.summary(timingSlot, timing))
+		}
+		delete(s.timings, timingSlot)
+	}
+	s.timingsMu.Unlock()
+
+	for _, summary := range summaries {
+		log.Trace().Stringer("data", summary).Msg("Blob delay summary")
+		s.submitter.SubmitBlobDelaySummary(ctx, summary)
+	}
+}
+
+// summary builds the summary for a block on a single node.
+func (s *Service) summary(slot phase0.Slot, timing *blockTiming) *submitter.BlobDelaySummary {
+	summary := &submitter.BlobDelaySummary{
+		Source:     s.nodeStatus.Status(timing.address).Version,
+		Method:     "blob sidecar event",
+		Slot:       slot,
+		BlockRoot:  timing.root,
+		BlockSeen:  timing.blockSeen,
+		BlockDelay: timing.blockDelay,
+		Blobs:      make([]*submitter.BlobDelay, 0, len(timing.blobs)),
+	}
+	for index, delay := range timing.blobs {
+		blob := &submitter.BlobDelay{
+			Index: index,
+			Delay: delay,
+		}
+		if timing.blockSeen {
+			blob.SinceBlock = delay - timing.blockDelay
+		}
+		summary.Blobs = append(summary.Blobs, blob)
+	}
+	sort.Slice(summary.Blobs, func(i, j int) bool {
+		return summary.Blobs[i].Index < summary.Blobs[j].Index
+	})
+
+	return summary
+}
@@ -0,0 +1,262 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// blockDetails are the details of a block that are fetched once per root.
+type blockDetails struct {
+	slot phase0.Slot
+	// done is closed once the details have been fetched.
+	done      chan struct{}
+	size      uint64
+	blobCount uint64
+	err       error
+}
+
+// epochDuties are the proposer duties for an epoch, which are fetched once per epoch.
+type epochDuties struct {
+	// done is closed once the duties have been fetched.
+	done   chan struct{}
+	duties map[phase0.Slot]phase0.ValidatorIndex
+	err    error
+}
+
+// proposer returns the proposer for the given slot, fetching and caching
+// proposer duties for its epoch if required.
+func (s *Service) proposer(ctx context.Context, slot phase0.Slot) (phase0.ValidatorIndex, error) {
+	duties, err := s.epochDuties(ctx, s.chainTime.SlotToEpoch(slot))
+	if err != nil {
+		return 0, err
+	}
+
+	proposerIndex, exists := duties[slot]
+	if !exists {
+		return 0, fmt.Errorf("no proposer duty for slot %d", slot)
+	}
+
+	return proposerIndex, nil
+}
+
+// epochDuties returns the proposer duties for the given epoch.  The lock is
+// not held while fetching, and concurrent callers wait for the single fetch
+// in flight rather than making their own.
+func (s *Service) epochDuties(ctx context.Context,
+	epoch phase0.Epoch,
+) (
+	map[phase0.Slot]phase0.ValidatorIndex,
+	error,
+) {
+	s.dutiesMu.Lock()
+	entry, exists := s.proposerDuties[epoch]
+	if exists {
+		s.dutiesMu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-entry.done:
+		}
+
+		return entry.duties, entry.err
+	}
+	entry = &epochDuties{
+		done: make(chan struct{}),
+	}
+	s.proposerDuties[epoch] = entry
+	// Only the current and previous epochs are of interest.
+	for dutiesEpoch := range s.proposerDuties {
+		if dutiesEpoch+1 < epoch {
+			delete(s.proposerDuties, dutiesEpoch)
+		}
+	}
+	s.dutiesMu.Unlock()
+
+	entry.duties, entry.err = s.fetchDuties(ctx, epoch)
+	if entry.err != nil {
+		// Remove the failed entry so that a later caller can try again.
+		s.dutiesMu.Lock()
+		if s.proposerDuties[epoch] == entry {
+			delete(s.proposerDuties, epoch)
+		}
+		s.dutiesMu.Unlock()
+	}
+	close(entry.done)
+
+	return entry.duties, entry.err
+}
+
+// fetchDuties fetches the proposer duties for the given epoch.
+func (s *Service) fetchDuties(ctx context.Context,
+	epoch phase0.Epoch,
+) (
+	map[phase0.Slot]phase0.ValidatorIndex,
+	error,
+) {
+	response, err := s.proposerDutiesProvider.ProposerDuties(ctx, &api.ProposerDutiesOpts{
+		Epoch: epoch,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain proposer duties")
+	}
+	duties := make(map[phase0.Slot]phase0.ValidatorIndex, len(response.Data))
+	for _, duty := range response.Data {
+		duties[duty.Slot] = duty.ValidatorIndex
+	}
+
+	return duties, nil
+}
+
+// blockDetails returns the details of the given block, fetching them from
+// the node at the given address if they have not already been fetched.
+func (s *Service) blockDetails(ctx context.Context,
+	address string,
+	slot phase0.Slot,
+	root phase0.Root,
+) (
+	*blockDetails,
+	error,
+) {
+	s.blocksMu.Lock()
+	details, exists := s.blocks[root]
+	if exists {
+		s.blocksMu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-details.done:
+		}
+
+		return details, details.err
+	}
+	details = &blockDetails{
+		slot: slot,
+		done: make(chan struct{}),
+	}
+	s.blocks[root] = details
+	s.blocksMu.Unlock()
+
+	details.size, details.blobCount, details.err = s.fetchBlockDetails(ctx, address, root)
+	close(details.done)
+
+	return details, details.err
+}
+
+// fetchBlockDetails fetches the size and blob count of the given block.
+func (s *Service) fetchBlockDetails(ctx context.Context,
+	address string,
+	root phase0.Root,
+) (
+	uint64,
+	uint64,
+	error,
+) {
+	provider, exists := s.signedBeaconBlockProviders[address]
+	if !exists {
+		return 0, 0, fmt.Errorf("no signed beacon block provider for %s", address)
+	}
+
+	response, err := provider.SignedBeaconBlock(ctx, &api.SignedBeaconBlockOpts{
+		Block: root.String(),
+	})
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to obtain block")
+	}
+	block := response.Data
+
+	size, err := blockSize(block)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if block.Version < spec.DataVersionDeneb {
+		// No blobs prior to Deneb.
+		return size, 0, nil
+	}
+	commitments, err := block.BlobKZGCommitments()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to obtain blob commitments")
+	}
+
+	return size, uint64(len(commitments)), nil
+}
+
+// pruneBlockDetails removes the details of blocks before the given slot.
+func (s *Service) pruneBlockDetails(slot phase0.Slot) {
+	s.blocksMu.Lock()
+	defer s.blocksMu.Unlock()
+
+	for root, details := range s.blocks {
+		if details.slot < slot {
+			delete(s.blocks, root)
+		}
+	}
+}
+
+// blockSize returns the size of the SSZ-encoded signed block.
+func blockSize(block *spec.VersionedSignedBeaconBlock) (uint64, error) {
+	switch block.Version {
+	case spec.DataVersionPhase0:
+		if block.Phase0 == nil {
+			return 0, errors.New("no phase0 block")
+		}
+
+		return uint64(block.Phase0.SizeSSZ()), nil
+	case spec.DataVersionAltair:
+		if block.Altair == nil {
+			return 0, errors.New("no altair block")
+		}
+
+		return uint64(block.Altair.SizeSSZ()), nil
+	case spec.DataVersionBellatrix:
+		if block.Bellatrix == nil {
+			return 0, errors.New("no bellatrix block")
+		}
+
+		return uint64(block.Bellatrix.SizeSSZ()), nil
+	case spec.DataVersionCapella:
+		if block.Capella == nil {
+			return 0, errors.New("no capella block")
+		}
+
+		return uint64(block.Capella.SizeSSZ()), nil
+	case spec.DataVersionDeneb:
+		if block.Deneb == nil {
+			return 0, errors.New("no deneb block")
+		}
+
+		return uint64(block.Deneb.SizeSSZ()), nil
+	case spec.DataVersionElectra:
+		if block.Electra == nil {
+			return 0, errors.New("no electra block")
+		}
+
+		return uint64(block.Electra.SizeSSZ()), nil
+	case spec.DataVersionFulu:
+		if block.Fulu == nil {
+			return 0, errors.New("no fulu block")
+		}
+
+		return uint64(block.Fulu.SizeSSZ()), nil
+	default:
+		return 0, fmt.Errorf("unhandled block version %v", block.Version)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	standardchaintime "github.com/wealdtech/probec/services/chaintime/standard"
+)
+
+func TestDetails(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisProvider(mockClient),
+		standardchaintime.WithSpecProvider(mockClient),
+		standardchaintime.WithForkScheduleProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	dutiesCalls := 0
+	mockClient.ProposerDutiesFunc = func(_ context.Context, opts *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error) {
+		dutiesCalls++
+		firstSlot := chainTime.FirstSlotOfEpoch(opts.Epoch)
+		duties := make([]*apiv1.ProposerDuty, 0, chainTime.SlotsPerEpoch())
+		for i := range chainTime.SlotsPerEpoch() {
+			duties = append(duties, &apiv1.ProposerDuty{
+				Slot:           firstSlot + phase0.Slot(i),
+				ValidatorIndex: phase0.ValidatorIndex(1000 + uint64(firstSlot) + i),
+			})
+		}
+
+		return &api.Response[[]*apiv1.ProposerDuty]{Data: duties}, nil
+	}
+	blockCalls := 0
+	mockClient.SignedBeaconBlockFunc = func(_ context.Context, _ *api.SignedBeaconBlockOpts) (*api.Response[*spec.VersionedSignedBeaconBlock], error) {
+		blockCalls++
+
+		return &api.Response[*spec.VersionedSignedBeaconBlock]{
+			Data: &spec.VersionedSignedBeaconBlock{
+				Version: spec.DataVersionPhase0,
+				Phase0: &phase0.SignedBeaconBlock{
+					Message: &phase0.BeaconBlock{
+						Body: &phase0.BeaconBlockBody{
+							ETH1Data: &phase0.ETH1Data{},
+						},
+					},
+				},
+			},
+		}, nil
+	}
+
+	s := &Service{
+		chainTime:              chainTime,
+		proposerDutiesProvider: mockClient,
+		signedBeaconBlockProviders: map[string]consensusclient.SignedBeaconBlockProvider{
+			"test": mockClient,
+		},
+		proposerDuties: make(map[phase0.Epoch]*epochDuties),
+		blocks:         make(map[phase0.Root]*blockDetails),
+	}
+
+	// Duties are fetched once per epoch.
+	proposerIndex, err := s.proposer(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(1001), proposerIndex)
+	proposerIndex, err = s.proposer(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(1002), proposerIndex)
+	require.Equal(t, 1, dutiesCalls)
+	nextEpochSlot := chainTime.FirstSlotOfEpoch(1)
+	proposerIndex, err = s.proposer(ctx, nextEpochSlot)
+	require.NoError(t, err)
+	require.Equal(t, phase0.ValidatorIndex(1000+uint64(nextEpochSlot)), proposerIndex)
+	require.Equal(t, 2, dutiesCalls)
+
+	// Block details are fetched once per root.
+	root := phase0.Root{0x01}
+	details, err := s.blockDetails(ctx, "test", 1, root)
+	require.NoError(t, err)
+	require.NotZero(t, details.size)
+	require.Zero(t, details.blobCount)
+	_, err = s.blockDetails(ctx, "test", 1, root)
+	require.NoError(t, err)
+	require.Equal(t, 1, blockCalls)
+	s.pruneBlockDetails(2)
+	_, err = s.blockDetails(ctx, "test", 1, root)
+	require.NoError(t, err)
+	require.Equal(t, 2, blockCalls)
+
+	// Unknown nodes cannot provide blocks.
+	_, err = s.blockDetails(ctx, "unknown", 1, phase0.Root{0x02})
+	require.EqualError(t, err, "no signed beacon block provider for unknown")
+}
+
+func TestProposerFetchedOnce(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithGenesisProvider(mockClient),
+		standardchaintime.WithSpecProvider(mockClient),
+		standardchaintime.WithForkScheduleProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	var dutiesCalls atomic.Int32
+	var dutiesErr error
+	mockClient.ProposerDutiesFunc = func(_ context.Context, opts *api.ProposerDutiesOpts) (*api.Response[[]*apiv1.ProposerDuty], error) {
+		dutiesCalls.Add(1)
+		<-release
+		if dutiesErr != nil {
+			return nil, dutiesErr
+		}
+
+		return &api.Response[[]*apiv1.ProposerDuty]{
+			Data: []*apiv1.ProposerDuty{
+				{
+					Slot:           chainTime.FirstSlotOfEpoch(opts.Epoch),
+					ValidatorIndex: 1000,
+				},
+			},
+		}, nil
+	}
+
+	s := &Service{
+		chainTime:              chainTime,
+		proposerDutiesProvider: mockClient,
+		proposerDuties:         make(map[phase0.Epoch]*epochDuties),
+	}
+
+	// Concurrent callers share a single fetch.
+	proposerIndices := make([]phase0.ValidatorIndex, 10)
+	errs := make([]error, 10)
+	var wg sync.WaitGroup
+	for i := range proposerIndices {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			proposerIndices[i], errs[i] = s.proposer(ctx, 0)
+		}(i)
+	}
+	require.Eventually(t, func() bool {
+		return dutiesCalls.Load() == 1
+	}, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(1), dutiesCalls.Load())
+	for i := range proposerIndices {
+		require.NoError(t, errs[i])
+		require.Equal(t, phase0.ValidatorIndex(1000), proposerIndices[i])
+	}
+
+	// A failed fetch is not cached.
+	dutiesErr = errors.New("unavailable")
+	nextEpochSlot := chainTime.FirstSlotOfEpoch(1)
+	_, err = s.proposer(ctx, nextEpochSlot)
+	require.EqualError(t, err, "failed to obtain proposer duties: unavailable")
+	dutiesErr = nil
+	_, err = s.proposer(ctx, nextEpochSlot)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), dutiesCalls.Load())
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -25,6 +25,10 @@ var (
 	delayTimer      prometheus.Histogram
 	latestTimestamp prometheus.Gauge
 	eventsReceived  prometheus.Counter
+	eventsIgnored   prometheus.Counter
+
+	propagationRank   *prometheus.GaugeVec
+	propagationSpread prometheus.Gauge
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -84,8 +88,38 @@ func registerPrometheusMetrics(_ context.Context) error {
 		Name:      "events_total",
 		Help:      "The number of block events received.",
 	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "blocks",
+		Name:      "events_ignored_total",
+		Help:      "The number of block events ignored because their node was not in a submittable state.",
+	})
+	if err := prometheus.Register(eventsIgnored); err != nil {
+		return err
+	}
 
-	return prometheus.Register(eventsReceived)
+	propagationRank = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "blocks",
+		Name:      "propagation_rank",
+		Help:      "The rank of the node in seeing the most recently summarised block, starting at 1; 0 if the node did not see it.",
+	}, []string{"node"})
+	if err := prometheus.Register(propagationRank); err != nil {
+		return err
+	}
+
+	propagationSpread = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "blocks",
+		Name:      "propagation_spread_seconds",
+		Help:      "The time between the fastest and slowest nodes to see the most recently summarised block.",
+	})
+
+	return prometheus.Register(propagationSpread)
 }
 
 // monitorEventSeen is called when a block event has been seen.
@@ -98,3 +132,30 @@ func monitorEventProcessed(delay time.Duration) {
 	eventsReceived.Inc()
 	delayTimer.Observe(delay.Seconds())
 }
+
+// monitorEventIgnored is called when a block event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
+
+// monitorPropagationRank is called when the rank of a node in seeing a block is known.
+func monitorPropagationRank(node string, rank uint64) {
+	if propagationRank == nil {
+		return
+	}
+
+	propagationRank.WithLabelValues(node).Set(float64(rank))
+}
+
+// monitorPropagationSpread is called when the spread of a block across nodes is known.
+func monitorPropagationSpread(spread time.Duration) {
+	if propagationSpread == nil {
+		return
+	}
+
+	propagationSpread.Set(spread.Seconds())
+}
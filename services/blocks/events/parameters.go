@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -21,16 +21,22 @@ import (
 	"github.com/wealdtech/probec/services/chaintime"
 	"github.com/wealdtech/probec/services/metrics"
 	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
 	"github.com/wealdtech/probec/services/submitter"
 )
 
 type parameters struct {
-	logLevel             zerolog.Level
-	monitor              metrics.Service
-	chainTime            chaintime.Service
-	eventsProviders      map[string]consensusclient.EventsProvider
-	nodeVersionProviders map[string]consensusclient.NodeVersionProvider
-	submitter            submitter.Service
+	logLevel        zerolog.Level
+	monitor         metrics.Service
+	chainTime       chaintime.Service
+	eventsProviders map[string]consensusclient.EventsProvider
+	nodeStatus      nodestatus.Service
+	submitter       submitter.Service
+	streams         streams.Service
+
+	proposerDutiesProvider     consensusclient.ProposerDutiesProvider
+	signedBeaconBlockProviders map[string]consensusclient.SignedBeaconBlockProvider
 }
 
 // Parameter is the interface for service parameters.
@@ -72,10 +78,10 @@ func WithEventsProviders(providers map[string]consensusclient.EventsProvider) Pa
 	})
 }
 
-// WithNodeVersionProviders sets the node version providers for this module.
-func WithNodeVersionProviders(providers map[string]consensusclient.NodeVersionProvider) Parameter {
+// WithNodeStatus sets the node status service for this module.
+func WithNodeStatus(service nodestatus.Service) Parameter {
 	return parameterFunc(func(p *parameters) {
-		p.nodeVersionProviders = providers
+		p.nodeStatus = service
 	})
 }
 
@@ -86,6 +92,27 @@ func WithSubmitter(submitter submitter.Service) Parameter {
 	})
 }
 
+// WithStreams sets the streams service for this module.
+func WithStreams(service streams.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.streams = service
+	})
+}
+
+// WithProposerDutiesProvider sets the proposer duties provider for this module.
+func WithProposerDutiesProvider(provider consensusclient.ProposerDutiesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.proposerDutiesProvider = provider
+	})
+}
+
+// WithSignedBeaconBlockProviders sets the signed beacon block providers for this module.
+func WithSignedBeaconBlockProviders(providers map[string]consensusclient.SignedBeaconBlockProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signedBeaconBlockProviders = providers
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -107,12 +134,21 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if len(parameters.eventsProviders) == 0 {
 		return nil, errors.New("events providers not supplied")
 	}
-	if len(parameters.nodeVersionProviders) == 0 {
-		return nil, errors.New("node version providers not supplied")
+	if parameters.nodeStatus == nil {
+		return nil, errors.New("node status service not supplied")
 	}
 	if parameters.submitter == nil {
 		return nil, errors.New("submitter not supplied")
 	}
+	if parameters.streams == nil {
+		return nil, errors.New("streams service not supplied")
+	}
+	if parameters.proposerDutiesProvider == nil {
+		return nil, errors.New("proposer duties provider not supplied")
+	}
+	if len(parameters.signedBeaconBlockProviders) == 0 {
+		return nil, errors.New("signed beacon block providers not supplied")
+	}
 
 	return &parameters, nil
 }
@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,23 +15,41 @@ package events
 
 import (
 	"context"
-	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
 	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
 	"github.com/wealdtech/probec/services/submitter"
 )
 
 // Service is a fee recipient provider service.
 type Service struct {
-	chainTime chaintime.Service
-	submitter submitter.Service
+	chainTime  chaintime.Service
+	submitter  submitter.Service
+	streams    streams.Service
+	nodeStatus nodestatus.Service
+	addresses  []string
+
+	proposerDutiesProvider     consensusclient.ProposerDutiesProvider
+	signedBeaconBlockProviders map[string]consensusclient.SignedBeaconBlockProvider
+	dutiesMu                   sync.Mutex
+	proposerDuties             map[phase0.Epoch]*epochDuties
+	blocksMu                   sync.Mutex
+	blocks                     map[phase0.Root]*blockDetails
+
+	// sightings are the delays with which each node saw each block, keyed by slot, root and address.
+	sightingsMu sync.Mutex
+	sightings   map[phase0.Slot]map[phase0.Root]map[string]time.Duration
 }
 
 // module-wide log.
@@ -55,59 +73,54 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	}
 
 	s := &Service{
-		chainTime: parameters.chainTime,
-		submitter: parameters.submitter,
+		chainTime:  parameters.chainTime,
+		submitter:  parameters.submitter,
+		streams:    parameters.streams,
+		nodeStatus: parameters.nodeStatus,
+		addresses:  make([]string, 0, len(parameters.eventsProviders)),
+		sightings:  make(map[phase0.Slot]map[phase0.Root]map[string]time.Duration),
+
+		proposerDutiesProvider:     parameters.proposerDutiesProvider,
+		signedBeaconBlockProviders: parameters.signedBeaconBlockProviders,
+		proposerDuties:             make(map[phase0.Epoch]*epochDuties),
+		blocks:                     make(map[phase0.Root]*blockDetails),
 	}
 
 	for address, eventsProvider := range parameters.eventsProviders {
-		if err := s.monitorEvents(ctx, eventsProvider, parameters.nodeVersionProviders[address]); err != nil {
+		s.addresses = append(s.addresses, address)
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
 			return nil, err
 		}
 	}
+	sort.Strings(s.addresses)
+
+	go s.submitPropagationSummaries(ctx)
 
 	return s, nil
 }
 
 func (s *Service) monitorEvents(ctx context.Context,
+	address string,
 	eventsProvider consensusclient.EventsProvider,
-	nodeVersionProvider consensusclient.NodeVersionProvider,
 ) error {
-	if err := eventsProvider.Events(ctx, &api.EventsOpts{
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
 		Topics: []string{"block"},
 		BlockHandler: func(ctx context.Context, event *apiv1.BlockEvent) {
 			delay := time.Since(s.chainTime.StartOfSlot(event.Slot))
 
-			// Ensure the node is synced.
-			syncingProvider, ok := eventsProvider.(consensusclient.NodeSyncingProvider)
-			if !ok {
-				log.Error().Msg("Node syncing provider not supported")
+			// Ensure the node is in a state to provide useful information.
+			if !s.nodeStatus.Submittable(address) {
+				log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+				monitorEventIgnored()
 				return
 			}
-			syncingResponse, err := syncingProvider.NodeSyncing(ctx, &api.NodeSyncingOpts{})
-			if err != nil {
-				log.Error().Err(err).Msg("Failed to ascertain if node is syncing")
-				return
-			}
-			if syncingResponse.Data.IsSyncing {
-				log.Debug().Msg("Node is syncing, not sending information")
-			}
 
 			monitorEventProcessed(delay)
 
-			nodeVersionResponse, err := nodeVersionProvider.NodeVersion(ctx, &api.NodeVersionOpts{})
-			if err != nil {
-				log.Error().Err(err).Msg("Failed to obtain node version")
-				return
-			}
+			s.recordSighting(address, event, delay)
 
-			// Build and send the data.
-			body := fmt.Sprintf(
-				`{"source":"%s","method":"block event","slot":"%d","delay_ms":"%d"}`,
-				nodeVersionResponse.Data,
-				event.Slot,
-				int(delay.Milliseconds()),
-			)
-			s.submitter.SubmitBlockDelay(ctx, body)
+			// Obtaining the block details can take some time, so do not hold up the stream.
+			go s.submitBlockDelay(ctx, address, event, delay)
 		},
 	}); err != nil {
 		return errors.Wrap(err, "failed to create events provider")
@@ -115,3 +128,152 @@ func (s *Service) monitorEvents(ctx context.Context,
 
 	return nil
 }
+
+// submitBlockDelay submits the block delay, along with the details of the block.
+func (s *Service) submitBlockDelay(ctx context.Context,
+	address string,
+	event *apiv1.BlockEvent,
+	delay time.Duration,
+) {
+	data := &submitter.BlockDelay{
+		Source:              s.nodeStatus.Status(address).Version,
+		Method:              "block event",
+		Slot:                event.Slot,
+		BlockRoot:           event.Block,
+		ExecutionOptimistic: event.ExecutionOptimistic,
+		Delay:               delay,
+	}
+
+	proposerIndex, err := s.proposer(ctx, event.Slot)
+	if err != nil {
+		log.Debug().Err(err).Uint64("slot", uint64(event.Slot)).Msg("Failed to obtain proposer")
+	} else {
+		data.ProposerKnown = true
+		data.ProposerIndex = proposerIndex
+	}
+
+	details, err := s.blockDetails(ctx, address, event.Slot, event.Block)
+	if err != nil {
+		log.Debug().Err(err).Stringer("root", event.Block).Msg("Failed to obtain block details")
+	} else {
+		data.BlockFetched = true
+		data.Size = details.size
+		data.BlobCount = details.blobCount
+	}
+
+	s.submitter.SubmitBlockDelay(ctx, data)
+}
+
+// recordSighting records the sighting of a block by a node.
+func (s *Service) recordSighting(address string, event *apiv1.BlockEvent, delay time.Duration) {
+	s.sightingsMu.Lock()
+	defer s.sightingsMu.Unlock()
+
+	slotSightings, exists := s.sightings[event.Slot]
+	if !exists {
+		slotSightings = make(map[phase0.Root]map[string]time.Duration)
+		s.sightings[event.Slot] = slotSightings
+	}
+	blockSightings, exists := slotSightings[event.Block]
+	if !exists {
+		blockSightings = make(map[string]time.Duration)
+		slotSightings[event.Block] = blockSightings
+	}
+	if _, exists := blockSightings[address]; !exists {
+		blockSightings[address] = delay
+	}
+}
+
+// submitPropagationSummaries submits the propagation summaries for blocks
+// once they have had a full slot in which to propagate.
+func (s *Service) submitPropagationSummaries(ctx context.Context) {
+	for {
+		timer := time.NewTimer(time.Until(s.chainTime.StartOfSlot(s.chainTime.CurrentSlot() + 1)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		currentSlot := s.chainTime.CurrentSlot()
+		if currentSlot < 2 {
+			continue
+		}
+		s.submitPropagationSummariesBefore(ctx, currentSlot-1)
+		s.pruneBlockDetails(currentSlot - 1)
+	}
+}
+
+// submitPropagationSummariesBefore submits and removes the propagation summaries for slots before the given slot.
+func (s *Service) submitPropagationSummariesBefore(ctx context.Context, slot phase0.Slot) {
+	s.sightingsMu.Lock()
+	sightings := make(map[phase0.Slot]map[phase0.Root]map[string]time.Duration)
+	for sightingSlot, slotSightings := range s.sightings {
+		if sightingSlot >= slot {
+			continue
+		}
+		sightings[sightingSlot] = slotSightings
+		delete(s.sightings, sightingSlot)
+	}
+	s.sightingsMu.Unlock()
+
+	for sightingSlot, slotSightings := range sightings {
+		for root, blockSightings := range slotSightings {
+			summary := s.propagationSummary(sightingSlot, root, blockSightings)
+			for _, node := range summary.Nodes {
+				monitorPropagationRank(node.Node, node.Rank)
+			}
+			for _, address := range summary.Missing {
+				monitorPropagationRank(address, 0)
+			}
+			monitorPropagationSpread(summary.Spread)
+
+			log.Trace().Stringer("data", summary).Msg("Block propagation summary")
+			s.submitter.SubmitBlockPropagationSummary(ctx, summary)
+		}
+	}
+}
+
+// propagationSummary builds the propagation summary for a block.
+func (s *Service) propagationSummary(slot phase0.Slot,
+	root phase0.Root,
+	sightings map[string]time.Duration,
+) *submitter.BlockPropagationSummary {
+	summary := &submitter.BlockPropagationSummary{
+		Method:    "block event",
+		Slot:      slot,
+		BlockRoot: root,
+		Nodes:     make([]*submitter.BlockPropagationNode, 0, len(sightings)),
+		Missing:   make([]string, 0),
+	}
+	for address, delay := range sightings {
+		summary.Nodes = append(summary.Nodes, &submitter.BlockPropagationNode{
+			Node:   address,
+			Source: s.nodeStatus.Status(address).Version,
+			Delay:  delay,
+		})
+	}
+	sort.Slice(summary.Nodes, func(i, j int) bool {
+		if summary.Nodes[i].Delay != summary.Nodes[j].Delay {
+			return summary.Nodes[i].Delay < summary.Nodes[j].Delay
+		}
+
+		return summary.Nodes[i].Node < summary.Nodes[j].Node
+	})
+	for i := range summary.Nodes {
+		summary.Nodes[i].Rank = uint64(i + 1)
+	}
+	summary.FirstSeen = summary.Nodes[0].Node
+	summary.Spread = summary.Nodes[len(summary.Nodes)-1].Delay - summary.Nodes[0].Delay
+
+	// Nodes that are not in a submittable state have their events ignored,
+	// so are not considered to have missed the block.
+	for _, address := range s.addresses {
+		if _, exists := sightings[address]; !exists && s.nodeStatus.Submittable(address) {
+			summary.Missing = append(summary.Missing, address)
+		}
+	}
+
+	return summary
+}
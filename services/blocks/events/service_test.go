@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -23,6 +23,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/wealdtech/probec/services/blocks/events"
 	standardchaintime "github.com/wealdtech/probec/services/chaintime/standard"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	mockstreams "github.com/wealdtech/probec/services/streams/mock"
 	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
 )
 
@@ -40,6 +42,8 @@ func TestService(t *testing.T) {
 	require.NoError(t, err)
 
 	submitter := mocksubmitter.New()
+	nodeStatus := mocknodestatus.New()
+	streams := mockstreams.New()
 
 	tests := []struct {
 		name   string
@@ -55,10 +59,13 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithProposerDutiesProvider(mockClient),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{
 					"test": mockClient,
 				}),
-				events.WithSubmitter(submitter),
 			},
 			err: "problem with parameters: monitor not supplied",
 		},
@@ -69,10 +76,13 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithProposerDutiesProvider(mockClient),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{
 					"test": mockClient,
 				}),
-				events.WithSubmitter(submitter),
 			},
 			err: "problem with parameters: chain time service not supplied",
 		},
@@ -82,15 +92,18 @@ func TestService(t *testing.T) {
 				events.WithLogLevel(zerolog.Disabled),
 				events.WithChainTime(chainTime),
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithProposerDutiesProvider(mockClient),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{
 					"test": mockClient,
 				}),
-				events.WithSubmitter(submitter),
 			},
 			err: "problem with parameters: events providers not supplied",
 		},
 		{
-			name: "NodeVersionProvidersMissing",
+			name: "NodeStatusMissing",
 			params: []events.Parameter{
 				events.WithLogLevel(zerolog.Disabled),
 				events.WithChainTime(chainTime),
@@ -98,8 +111,13 @@ func TestService(t *testing.T) {
 					"test": mockClient,
 				}),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithProposerDutiesProvider(mockClient),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{
+					"test": mockClient,
+				}),
 			},
-			err: "problem with parameters: node version providers not supplied",
+			err: "problem with parameters: node status service not supplied",
 		},
 		{
 			name: "SubmitterMissing",
@@ -109,24 +127,80 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
+				events.WithNodeStatus(nodeStatus),
+				events.WithStreams(streams),
+				events.WithProposerDutiesProvider(mockClient),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{
 					"test": mockClient,
 				}),
 			},
 			err: "problem with parameters: submitter not supplied",
 		},
 		{
-			name: "Good",
+			name: "StreamsMissing",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithProposerDutiesProvider(mockClient),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{
+					"test": mockClient,
+				}),
+			},
+			err: "problem with parameters: streams service not supplied",
+		},
+		{
+			name: "ProposerDutiesProviderMissing",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
+					"test": mockClient,
+				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{
+					"test": mockClient,
+				}),
+			},
+			err: "problem with parameters: proposer duties provider not supplied",
+		},
+		{
+			name: "SignedBeaconBlockProvidersEmpty",
 			params: []events.Parameter{
 				events.WithLogLevel(zerolog.Disabled),
 				events.WithChainTime(chainTime),
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithProposerDutiesProvider(mockClient),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{}),
+			},
+			err: "problem with parameters: signed beacon block providers not supplied",
+		},
+		{
+			name: "Good",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
+				events.WithNodeStatus(nodeStatus),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
+				events.WithProposerDutiesProvider(mockClient),
+				events.WithSignedBeaconBlockProviders(map[string]consensusclient.SignedBeaconBlockProvider{
+					"test": mockClient,
+				}),
 			},
 		},
 	}
@@ -1,4 +1,4 @@
-// Copyright © 2023 Weald Technology Trading.
+// Copyright © 2023, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -120,3 +120,18 @@ func (s *service) BellatrixInitialEpoch() phase0.Epoch {
 func (s *service) CapellaInitialEpoch() phase0.Epoch {
 	return 0
 }
+
+// DenebInitialEpoch provides the epoch at which the Deneb hard fork takes place.
+func (s *service) DenebInitialEpoch() phase0.Epoch {
+	return 0
+}
+
+// ElectraInitialEpoch provides the epoch at which the Electra hard fork takes place.
+func (s *service) ElectraInitialEpoch() phase0.Epoch {
+	return 0
+}
+
+// FuluInitialEpoch provides the epoch at which the Fulu hard fork takes place.
+func (s *service) FuluInitialEpoch() phase0.Epoch {
+	return 0
+}
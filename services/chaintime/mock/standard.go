@@ -0,0 +1,60 @@
+// Copyright © 2023, 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/chaintime/standard"
+)
+
+// NewStandard creates a standard chain time service backed by a mock
+// client, with the given genesis time and slot duration.  It is for tests
+// that require a chain time that follows the clock.
+func NewStandard(genesisTime time.Time, slotDuration time.Duration) (chaintime.Service, error) {
+	// The mock client is created with a background context, as it sets a
+	// package-level logger that its goroutine reads after cancellation.
+	ctx := context.Background()
+
+	client, err := mock.New(ctx, mock.WithGenesisTime(genesisTime))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create mock client")
+	}
+
+	specResponse, err := client.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+	spec := specResponse.Data
+	spec["SECONDS_PER_SLOT"] = slotDuration
+	client.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data:     spec,
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	return standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithGenesisProvider(client),
+		standard.WithSpecProvider(client),
+		standard.WithForkScheduleProvider(client),
+	)
+}
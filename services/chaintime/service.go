@@ -1,4 +1,4 @@
-// Copyright © 2023 Weald Technology Trading.
+// Copyright © 2023, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -63,4 +63,10 @@ type Service interface {
 	BellatrixInitialEpoch() phase0.Epoch
 	// CapellaInitialEpoch provides the epoch at which the Capella hard fork takes place.
 	CapellaInitialEpoch() phase0.Epoch
+	// DenebInitialEpoch provides the epoch at which the Deneb hard fork takes place.
+	DenebInitialEpoch() phase0.Epoch
+	// ElectraInitialEpoch provides the epoch at which the Electra hard fork takes place.
+	ElectraInitialEpoch() phase0.Epoch
+	// FuluInitialEpoch provides the epoch at which the Fulu hard fork takes place.
+	FuluInitialEpoch() phase0.Epoch
 }
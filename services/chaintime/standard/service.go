@@ -1,4 +1,4 @@
-// Copyright © 2023, 2024 Weald Technology Trading.
+// Copyright © 2023 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -36,6 +36,7 @@ type Service struct {
 	capellaForkEpoch             phase0.Epoch
 	denebForkEpoch               phase0.Epoch
 	electraForkEpoch             phase0.Epoch
+	fuluForkEpoch                phase0.Epoch
 }
 
 // module-wide log.
@@ -120,6 +121,12 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		electraForkEpoch = 0xffffffffffffffff
 	}
 	log.Trace().Uint64("epoch", uint64(electraForkEpoch)).Msg("Obtained Electra fork epoch")
+	fuluForkEpoch, err := fetchFuluForkEpoch(ctx, parameters.specProvider)
+	if err != nil {
+		// Set to far future epoch.
+		fuluForkEpoch = 0xffffffffffffffff
+	}
+	log.Trace().Uint64("epoch", uint64(fuluForkEpoch)).Msg("Obtained Fulu fork epoch")
 
 	s := &Service{
 		genesisTime:                  genesisResponse.Data.GenesisTime,
@@ -131,6 +138,7 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		capellaForkEpoch:             capellaForkEpoch,
 		denebForkEpoch:               denebForkEpoch,
 		electraForkEpoch:             electraForkEpoch,
+		fuluForkEpoch:                fuluForkEpoch,
 	}
 
 	return s, nil
@@ -334,6 +342,11 @@ func fetchCapellaForkEpoch(ctx context.Context,
 	return phase0.Epoch(epoch), nil
 }
 
+// DenebInitialEpoch provides the epoch at which the Deneb hard fork takes place.
+func (s *Service) DenebInitialEpoch() phase0.Epoch {
+	return s.denebForkEpoch
+}
+
 func fetchDenebForkEpoch(ctx context.Context,
 	specProvider eth2client.SpecProvider,
 ) (
@@ -360,6 +373,11 @@ func fetchDenebForkEpoch(ctx context.Context,
 	return phase0.Epoch(epoch), nil
 }
 
+// ElectraInitialEpoch provides the epoch at which the Electra hard fork takes place.
+func (s *Service) ElectraInitialEpoch() phase0.Epoch {
+	return s.electraForkEpoch
+}
+
 func fetchElectraForkEpoch(ctx context.Context,
 	specProvider eth2client.SpecProvider,
 ) (
@@ -385,3 +403,34 @@ func fetchElectraForkEpoch(ctx context.Context,
 
 	return phase0.Epoch(epoch), nil
 }
+
+// FuluInitialEpoch provides the epoch at which the Fulu hard fork takes place.
+func (s *Service) FuluInitialEpoch() phase0.Epoch {
+	return s.fuluForkEpoch
+}
+
+func fetchFuluForkEpoch(ctx context.Context,
+	specProvider eth2client.SpecProvider,
+) (
+	phase0.Epoch,
+	error,
+) {
+	// Fetch the fork version.
+	specResponse, err := specProvider.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain spec")
+	}
+	spec := specResponse.Data
+
+	tmp, exists := spec["FULU_FORK_EPOCH"]
+	if !exists {
+		return 0, errors.New("fulu fork version not known by chain")
+	}
+	epoch, isEpoch := tmp.(uint64)
+	if !isEpoch {
+		//nolint:revive
+		return 0, errors.New("FULU_FORK_EPOCH is not a uint64!")
+	}
+
+	return phase0.Epoch(epoch), nil
+}
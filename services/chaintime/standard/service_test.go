@@ -1,4 +1,4 @@
-// Copyright © 2023 Weald Technology Trading.
+// Copyright © 2023, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -246,3 +246,34 @@ func TestTimestampToEpoch(t *testing.T) {
 		})
 	}
 }
+
+func TestForkEpochs(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx)
+	require.NoError(t, err)
+	client.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data: map[string]any{
+				"SECONDS_PER_SLOT":   12 * time.Second,
+				"SLOTS_PER_EPOCH":    uint64(32),
+				"DENEB_FORK_EPOCH":   uint64(10),
+				"ELECTRA_FORK_EPOCH": uint64(20),
+				"FULU_FORK_EPOCH":    uint64(30),
+			},
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	s, err := standard.New(ctx,
+		standard.WithGenesisProvider(client),
+		standard.WithSpecProvider(client),
+		standard.WithForkScheduleProvider(client),
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, phase0.Epoch(0xffffffffffffffff), s.CapellaInitialEpoch())
+	require.Equal(t, phase0.Epoch(10), s.DenebInitialEpoch())
+	require.Equal(t, phase0.Epoch(20), s.ElectraInitialEpoch())
+	require.Equal(t, phase0.Epoch(30), s.FuluInitialEpoch())
+}
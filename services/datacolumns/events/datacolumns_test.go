@@ -0,0 +1,155 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	mockchaintime "github.com/wealdtech/probec/services/chaintime/mock"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/submitter"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+// sourceNodeStatus provides the address of the node as its version, to identify summaries.
+type sourceNodeStatus struct {
+	submittable map[string]bool
+}
+
+func (n *sourceNodeStatus) Status(address string) *nodestatus.Status {
+	return &nodestatus.Status{Version: address}
+}
+
+func (n *sourceNodeStatus) Submittable(address string) bool {
+	return n.submittable[address]
+}
+
+func TestSubmitSummariesBefore(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := mockchaintime.NewStandard(time.Now().Add(-time.Hour), 12*time.Second)
+	require.NoError(t, err)
+
+	recorder := mocksubmitter.NewRecorder()
+	nodeStatus := &sourceNodeStatus{
+		submittable: map[string]bool{"a": true, "b": true, "c": true, "d": false},
+	}
+	s := &Service{
+		chainTime:      chainTime,
+		submitter:      recorder,
+		nodeStatus:     nodeStatus,
+		custodyColumns: map[string]uint64{"c": 2},
+		defaultCustody: 4,
+		addresses:      []string{"a", "b", "c", "d"},
+		blobCounts:     make(map[phase0.Slot]map[phase0.Root]uint64),
+		columns:        make(map[phase0.Slot]map[string]*blockColumns),
+	}
+
+	slot := chainTime.CurrentSlot() - 5
+	root := phase0.Root{0x01}
+	commitments := []deneb.KZGCommitment{{0x01}, {0x02}}
+
+	// Node a receives all of its custody columns, node b none of them and node c more than it custodies.
+	for _, index := range []uint64{0, 1, 2, 3} {
+		s.handleDataColumnSidecar("a", &apiv1.DataColumnSidecarEvent{Slot: slot, BlockRoot: root, Index: index, KZGCommitments: commitments})
+	}
+	for _, index := range []uint64{5, 6, 7} {
+		s.handleDataColumnSidecar("c", &apiv1.DataColumnSidecarEvent{Slot: slot, BlockRoot: root, Index: index, KZGCommitments: commitments})
+	}
+
+	// Node d is not submittable, so neither its columns nor its absence of them are reported.
+	s.handleDataColumnSidecar("d", &apiv1.DataColumnSidecarEvent{Slot: slot, BlockRoot: root, Index: 0, KZGCommitments: commitments})
+
+	// A column for a later slot is retained.
+	s.handleDataColumnSidecar("a", &apiv1.DataColumnSidecarEvent{Slot: slot + 1, BlockRoot: phase0.Root{0x02}, Index: 0, KZGCommitments: commitments})
+
+	s.submitSummariesBefore(ctx, slot+1)
+	summaries := make(map[string]*submitter.DataColumnSummary)
+	for _, summary := range recorder.DataColumnSummaries() {
+		summaries[summary.Source] = summary
+	}
+	require.Len(t, summaries, 3)
+
+	summary := summaries["a"]
+	require.Equal(t, slot, summary.Slot)
+	require.Equal(t, root, summary.BlockRoot)
+	require.Equal(t, uint64(2), summary.BlobCount)
+	require.Equal(t, uint64(4), summary.CustodyColumns)
+	require.Len(t, summary.Columns, 4)
+	require.Equal(t, 1.0, completeness(summary))
+
+	summary = summaries["b"]
+	require.Equal(t, slot, summary.Slot)
+	require.Equal(t, root, summary.BlockRoot)
+	require.Equal(t, uint64(2), summary.BlobCount)
+	require.Equal(t, uint64(4), summary.CustodyColumns)
+	require.Empty(t, summary.Columns)
+	require.Equal(t, 0.0, completeness(summary))
+
+	summary = summaries["c"]
+	require.Equal(t, uint64(2), summary.CustodyColumns)
+	require.Len(t, summary.Columns, 3)
+	require.Equal(t, []uint64{5, 6, 7}, []uint64{summary.Columns[0].Index, summary.Columns[1].Index, summary.Columns[2].Index})
+	require.Equal(t, 1.0, completeness(summary))
+
+	require.NotContains(t, s.columns, slot)
+	require.NotContains(t, s.blobCounts, slot)
+	require.Contains(t, s.columns, slot+1)
+	require.Contains(t, s.blobCounts, slot+1)
+}
+
+func TestCompleteness(t *testing.T) {
+	tests := []struct {
+		name     string
+		summary  *submitter.DataColumnSummary
+		expected float64
+	}{
+		{
+			name: "None",
+			summary: &submitter.DataColumnSummary{
+				CustodyColumns: 4,
+				Columns:        []*submitter.DataColumnDelay{},
+			},
+			expected: 0,
+		},
+		{
+			name: "Partial",
+			summary: &submitter.DataColumnSummary{
+				CustodyColumns: 4,
+				Columns:        []*submitter.DataColumnDelay{{Index: 1}},
+			},
+			expected: 0.25,
+		},
+		{
+			name: "Excess",
+			summary: &submitter.DataColumnSummary{
+				CustodyColumns: 1,
+				Columns:        []*submitter.DataColumnDelay{{Index: 1}, {Index: 2}},
+			},
+			expected: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, completeness(test.summary))
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	delayTimer          prometheus.Histogram
+	latestTimestamp     prometheus.Gauge
+	eventsReceived      prometheus.Counter
+	eventsIgnored       prometheus.Counter
+	custodyCompleteness *prometheus.GaugeVec
+	custodyIncomplete   *prometheus.CounterVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if latestTimestamp != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	delayTimer = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "datacolumns",
+		Name:      "delay_seconds",
+		Help:      "The time from the start of the slot to receipt of the data column sidecar event.",
+		Buckets:   prometheus.LinearBuckets(0.1, 0.1, 120),
+	})
+	if err := prometheus.Register(delayTimer); err != nil {
+		return err
+	}
+
+	latestTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "datacolumns",
+		Name:      "latest_timestamp",
+		Help:      "The latest timestamp at which probec obtained a data column sidecar event.",
+	})
+	if err := prometheus.Register(latestTimestamp); err != nil {
+		return err
+	}
+
+	eventsReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "datacolumns",
+		Name:      "events_total",
+		Help:      "The number of data column sidecar events received.",
+	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "datacolumns",
+		Name:      "events_ignored_total",
+		Help:      "The number of data column sidecar events ignored because their node was not in a submittable state.",
+	})
+	if err := prometheus.Register(eventsIgnored); err != nil {
+		return err
+	}
+
+	custodyCompleteness = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "datacolumns",
+		Name:      "custody_completeness",
+		Help:      "The proportion of custody columns received by the node for the latest block.",
+	}, []string{"address"})
+	if err := prometheus.Register(custodyCompleteness); err != nil {
+		return err
+	}
+
+	custodyIncomplete = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "datacolumns",
+		Name:      "custody_incomplete_total",
+		Help:      "The number of blocks for which the node did not receive all of its custody columns.",
+	}, []string{"address"})
+
+	return prometheus.Register(custodyIncomplete)
+}
+
+// monitorEventProcessed is called when a data column sidecar event has been processed.
+func monitorEventProcessed(delay time.Duration) {
+	if latestTimestamp == nil {
+		return
+	}
+
+	latestTimestamp.SetToCurrentTime()
+	eventsReceived.Inc()
+	delayTimer.Observe(delay.Seconds())
+}
+
+// monitorEventIgnored is called when a data column sidecar event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
+
+// monitorCustodyCompleteness is called when the custody completeness of a block has been calculated.
+func monitorCustodyCompleteness(address string, completeness float64) {
+	if custodyCompleteness == nil {
+		return
+	}
+
+	custodyCompleteness.WithLabelValues(address).Set(completeness)
+	if completeness < 1 {
+		custodyIncomplete.WithLabelValues(address).Inc()
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// farFutureEpoch is the epoch used by chain time for forks that are not scheduled.
+const farFutureEpoch = phase0.Epoch(0xffffffffffffffff)
+
+// blockColumns contains the arrival times of the data column sidecars of a block on a single node.
+type blockColumns struct {
+	address   string
+	root      phase0.Root
+	blobCount uint64
+	columns   map[uint64]time.Duration
+}
+
+// Service is a data column sidecar timing service.
+type Service struct {
+	chainTime      chaintime.Service
+	submitter      submitter.Service
+	streams        streams.Service
+	nodeStatus     nodestatus.Service
+	custodyColumns map[string]uint64
+	defaultCustody uint64
+	addresses      []string
+
+	// blobCounts contains the number of blobs in each block for which any
+	// node has received a data column sidecar, keyed by slot and root.
+	columnsMu  sync.Mutex
+	blobCounts map[phase0.Slot]map[phase0.Root]uint64
+	columns    map[phase0.Slot]map[string]*blockColumns
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new data column sidecar timing service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "datacolumns").Str("impl", "events").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:      parameters.chainTime,
+		submitter:      parameters.submitter,
+		streams:        parameters.streams,
+		nodeStatus:     parameters.nodeStatus,
+		custodyColumns: parameters.custodyColumns,
+		defaultCustody: parameters.defaultCustody,
+		addresses:      make([]string, 0, len(parameters.eventsProviders)),
+		blobCounts:     make(map[phase0.Slot]map[phase0.Root]uint64),
+		columns:        make(map[phase0.Slot]map[string]*blockColumns),
+	}
+
+	// Nodes reject subscriptions to topics they do not know, so only
+	// subscribe if the chain has scheduled the fork that introduces them.
+	if s.chainTime.FuluInitialEpoch() == farFutureEpoch {
+		log.Info().Msg("Fulu fork not scheduled; not monitoring data column sidecars")
+		return s, nil
+	}
+
+	for address, eventsProvider := range parameters.eventsProviders {
+		s.addresses = append(s.addresses, address)
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	go s.submitSummaries(ctx)
+
+	return s, nil
+}
+
+func (s *Service) monitorEvents(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+) error {
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: []string{"data_column_sidecar"},
+		DataColumnSidecarHandler: func(_ context.Context, event *apiv1.DataColumnSidecarEvent) {
+			s.handleDataColumnSidecar(address, event)
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create events provider")
+	}
+
+	return nil
+}
+
+func (s *Service) handleDataColumnSidecar(address string, event *apiv1.DataColumnSidecarEvent) {
+	delay := time.Since(s.chainTime.StartOfSlot(event.Slot))
+
+	// Ensure the node is in a state to provide useful information.
+	if !s.nodeStatus.Submittable(address) {
+		log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+		monitorEventIgnored()
+		return
+	}
+
+	s.columnsMu.Lock()
+	slotBlobCounts, exists := s.blobCounts[event.Slot]
+	if !exists {
+		slotBlobCounts = make(map[phase0.Root]uint64)
+		s.blobCounts[event.Slot] = slotBlobCounts
+	}
+	slotBlobCounts[event.BlockRoot] = uint64(len(event.KZGCommitments))
+	block := s.blockColumnsLocked(address, event.Slot, event.BlockRoot)
+	block.columns[event.Index] = delay
+	s.columnsMu.Unlock()
+
+	log.Trace().
+		Str("address", address).
+		Uint64("slot", uint64(event.Slot)).
+		Uint64("index", event.Index).
+		Stringer("delay", delay).
+		Msg("Received data column sidecar event")
+	monitorEventProcessed(delay)
+}
+
+// blockColumnsLocked returns the columns for the given block on the given
+// node, creating them if required; the caller must hold the lock.
+func (s *Service) blockColumnsLocked(address string, slot phase0.Slot, root phase0.Root) *blockColumns {
+	slotColumns, exists := s.columns[slot]
+	if !exists {
+		slotColumns = make(map[string]*blockColumns)
+		s.columns[slot] = slotColumns
+	}
+	key := fmt.Sprintf("%s:%#x", address, root)
+	block, exists := slotColumns[key]
+	if !exists {
+		block = &blockColumns{
+			address:   address,
+			root:      root,
+			blobCount: s.blobCounts[slot][root],
+			columns:   make(map[uint64]time.Duration),
+		}
+		slotColumns[key] = block
+	}
+
+	return block
+}
+
+// submitSummaries submits the summaries for each slot once its data column
+// sidecars have had a full slot in which to arrive.
+func (s *Service) submitSummaries(ctx context.Context) {
+	for {
+		timer := time.NewTimer(time.Until(s.chainTime.StartOfSlot(s.chainTime.CurrentSlot() + 1)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		currentSlot := s.chainTime.CurrentSlot()
+		if currentSlot < 2 {
+			continue
+		}
+		s.submitSummariesBefore(ctx, currentSlot-1)
+	}
+}
+
+// submitSummariesBefore submits and removes the summaries for slots before the given slot.
+//
+// A summary is submitted for every node for each block for which any node
+// received a data column sidecar, so nodes that received no columns for a
+// block are reported as such.
+func (s *Service) submitSummariesBefore(ctx context.Context, slot phase0.Slot) {
+	s.columnsMu.Lock()
+	blocks := make(map[phase0.Slot][]*blockColumns)
+	for blockSlot, slotBlobCounts := range s.blobCounts {
+		if blockSlot >= slot {
+			continue
+		}
+		for root := range slotBlobCounts {
+			for _, address := range s.addresses {
+				if _, exists := s.columns[blockSlot][fmt.Sprintf("%s:%#x", address, root)]; !exists &&
+					!s.nodeStatus.Submittable(address) {
+					// Node did not receive any columns but is not in a state to provide useful information.
+					continue
+				}
+				blocks[blockSlot] = append(blocks[blockSlot], s.blockColumnsLocked(address, blockSlot, root))
+			}
+		}
+		delete(s.blobCounts, blockSlot)
+		delete(s.columns, blockSlot)
+	}
+	s.columnsMu.Unlock()
+
+	for blockSlot, slotBlocks := range blocks {
+		for _, block := range slotBlocks {
+			summary := s.summary(blockSlot, block)
+			monitorCustodyCompleteness(block.address, completeness(summary))
+
+			log.Trace().Stringer("data", summary).Msg("Data column summary")
+			s.submitter.SubmitDataColumnSummary(ctx, summary)
+		}
+	}
+}
+
+// completeness returns the proportion of its custody columns that the node received.
+func completeness(summary *submitter.DataColumnSummary) float64 {
+	if summary.CustodyColumns == 0 {
+		return 1
+	}
+	res := float64(len(summary.Columns)) / float64(summary.CustodyColumns)
+	if res > 1 {
+		// Node custodies more columns than expected.
+		res = 1
+	}
+
+	return res
+}
+
+// summary builds the summary for a block on a single node.
+func (s *Service) summary(slot phase0.Slot, block *blockColumns) *submitter.DataColumnSummary {
+	custodyColumns, exists := s.custodyColumns[block.address]
+	if !exists {
+		custodyColumns = s.defaultCustody
+	}
+
+	summary := &submitter.DataColumnSummary{
+		Source:         s.nodeStatus.Status(block.address).Version,
+		Method:         "data column sidecar event",
+		Slot:           slot,
+		BlockRoot:      block.root,
+		BlobCount:      block.blobCount,
+		CustodyColumns: custodyColumns,
+		Columns:        make([]*submitter.DataColumnDelay, 0, len(block.columns)),
+	}
+	for index, delay := range block.columns {
+		summary.Columns = append(summary.Columns, &submitter.DataColumnDelay{
+			Index: index,
+			Delay: delay,
+		})
+	}
+	sort.Slice(summary.Columns, func(i, j int) bool {
+		return summary.Columns[i].Index < summary.Columns[j].Index
+	})
+
+	return summary
+}
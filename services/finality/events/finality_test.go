@@ -0,0 +1,127 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	mockchaintime "github.com/wealdtech/probec/services/chaintime/mock"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+func newTestService(t *testing.T, lateThreshold time.Duration) (*Service, *mocksubmitter.Recorder) {
+	t.Helper()
+
+	// Start the chain part-way through an epoch, sufficiently far in the past
+	// to have finalized epochs.
+	chainTime, err := mockchaintime.NewStandard(time.Now().Add(-20*384*time.Second-time.Minute), 12*time.Second)
+	require.NoError(t, err)
+
+	recorder := mocksubmitter.NewRecorder()
+
+	return &Service{
+		chainTime:        chainTime,
+		submitter:        recorder,
+		nodeStatus:       mocknodestatus.New(),
+		lateThreshold:    lateThreshold,
+		missingThreshold: time.Hour,
+		addresses:        []string{"a", "b"},
+		observedEpochs:   make(map[string]phase0.Epoch),
+	}, recorder
+}
+
+func TestHandleFinalizedCheckpoint(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		lateThreshold time.Duration
+		epochsBehind  phase0.Epoch
+		late          bool
+	}{
+		{
+			name:          "OnTime",
+			lateThreshold: time.Hour,
+			epochsBehind:  2,
+		},
+		{
+			name:          "LateEpoch",
+			lateThreshold: time.Hour,
+			epochsBehind:  3,
+			late:          true,
+		},
+		{
+			name:          "LateDelay",
+			lateThreshold: time.Nanosecond,
+			epochsBehind:  2,
+			late:          true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, recorder := newTestService(t, test.lateThreshold)
+
+			currentEpoch := s.chainTime.CurrentEpoch()
+			s.handleFinalizedCheckpoint(ctx, "a", &apiv1.FinalizedCheckpointEvent{
+				Block: phase0.Root{0x01},
+				State: phase0.Root{0x02},
+				Epoch: currentEpoch - test.epochsBehind,
+			})
+
+			delays := recorder.FinalityDelays()
+			require.Len(t, delays, 1)
+			delay := delays[0]
+			require.Equal(t, "mock", delay.Source)
+			require.Equal(t, currentEpoch-test.epochsBehind, delay.Epoch)
+			require.Equal(t, phase0.Root{0x01}, delay.Block)
+			require.Equal(t, currentEpoch, delay.ObservedEpoch)
+			require.Equal(t, test.late, delay.Late)
+			require.False(t, delay.Missing)
+			require.Equal(t, currentEpoch, s.observedEpochs["a"])
+		})
+	}
+}
+
+func TestCheckMissingForEpoch(t *testing.T) {
+	ctx := context.Background()
+
+	s, recorder := newTestService(t, time.Minute)
+
+	// Node a reports finality in epoch 10, node b does not.
+	s.observedEpochs["a"] = 10
+	s.checkMissingForEpoch(ctx, 10)
+	delays := recorder.FinalityDelays()
+	require.Len(t, delays, 1)
+	delay := delays[0]
+	require.Equal(t, "mock", delay.Source)
+	require.Equal(t, phase0.Epoch(8), delay.Epoch)
+	require.Equal(t, phase0.Epoch(10), delay.ObservedEpoch)
+	require.True(t, delay.Late)
+	require.True(t, delay.Missing)
+	require.Zero(t, delay.Delay)
+
+	// Neither node reports finality in epoch 11.
+	s.checkMissingForEpoch(ctx, 11)
+	delays = recorder.FinalityDelays()
+	require.Len(t, delays, 3)
+	require.True(t, delays[1].Missing)
+	require.True(t, delays[2].Missing)
+}
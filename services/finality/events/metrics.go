@@ -0,0 +1,154 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	delayTimer      prometheus.Histogram
+	latestTimestamp prometheus.Gauge
+	eventsReceived  prometheus.Counter
+	eventsIgnored   prometheus.Counter
+	finalizedEpoch  *prometheus.GaugeVec
+	lateTotal       *prometheus.CounterVec
+	missingTotal    *prometheus.CounterVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if latestTimestamp != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	delayTimer = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "finality",
+		Name:      "delay_seconds",
+		Help:      "The time from the start of the epoch to receipt of the finalized checkpoint event.",
+		Buckets:   prometheus.LinearBuckets(0.5, 0.5, 24),
+	})
+	if err := prometheus.Register(delayTimer); err != nil {
+		return err
+	}
+
+	latestTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "finality",
+		Name:      "latest_timestamp",
+		Help:      "The latest timestamp at which probec obtained a finalized checkpoint event.",
+	})
+	if err := prometheus.Register(latestTimestamp); err != nil {
+		return err
+	}
+
+	eventsReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "finality",
+		Name:      "events_total",
+		Help:      "The number of finalized checkpoint events received.",
+	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "finality",
+		Name:      "events_ignored_total",
+		Help:      "The number of finalized checkpoint events ignored because their node was not in a submittable state.",
+	})
+	if err := prometheus.Register(eventsIgnored); err != nil {
+		return err
+	}
+
+	finalizedEpoch = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "finality",
+		Name:      "finalized_epoch",
+		Help:      "The latest finalized epoch reported by the node.",
+	}, []string{"address"})
+	if err := prometheus.Register(finalizedEpoch); err != nil {
+		return err
+	}
+
+	lateTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "finality",
+		Name:      "late_total",
+		Help:      "The number of epochs for which the node reported finality late.",
+	}, []string{"address"})
+	if err := prometheus.Register(lateTotal); err != nil {
+		return err
+	}
+
+	missingTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "finality",
+		Name:      "missing_total",
+		Help:      "The number of epochs for which the node did not report finality.",
+	}, []string{"address"})
+
+	return prometheus.Register(missingTotal)
+}
+
+// monitorEventProcessed is called when a finalized checkpoint event has been processed.
+func monitorEventProcessed(address string, epoch phase0.Epoch, delay time.Duration, late bool) {
+	if latestTimestamp == nil {
+		return
+	}
+
+	latestTimestamp.SetToCurrentTime()
+	eventsReceived.Inc()
+	delayTimer.Observe(delay.Seconds())
+	finalizedEpoch.WithLabelValues(address).Set(float64(epoch))
+	if late {
+		lateTotal.WithLabelValues(address).Inc()
+	}
+}
+
+// monitorEventIgnored is called when a finalized checkpoint event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
+
+// monitorFinalityMissing is called when a node has not reported finality for an epoch.
+func monitorFinalityMissing(address string) {
+	if missingTotal == nil {
+		return
+	}
+
+	missingTotal.WithLabelValues(address).Inc()
+}
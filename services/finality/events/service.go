@@ -0,0 +1,205 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// Service is a finality latency tracking service.
+type Service struct {
+	chainTime        chaintime.Service
+	submitter        submitter.Service
+	streams          streams.Service
+	nodeStatus       nodestatus.Service
+	lateThreshold    time.Duration
+	missingThreshold time.Duration
+	addresses        []string
+
+	// observedEpochs contains the epoch in which each node last reported finality.
+	observedEpochsMu sync.Mutex
+	observedEpochs   map[string]phase0.Epoch
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new finality latency tracking service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "finality").Str("impl", "events").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:        parameters.chainTime,
+		submitter:        parameters.submitter,
+		streams:          parameters.streams,
+		nodeStatus:       parameters.nodeStatus,
+		lateThreshold:    parameters.lateThreshold,
+		missingThreshold: parameters.missingThreshold,
+		addresses:        make([]string, 0, len(parameters.eventsProviders)),
+		observedEpochs:   make(map[string]phase0.Epoch),
+	}
+
+	for address, eventsProvider := range parameters.eventsProviders {
+		s.addresses = append(s.addresses, address)
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	go s.checkMissing(ctx)
+
+	return s, nil
+}
+
+func (s *Service) monitorEvents(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+) error {
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: []string{"finalized_checkpoint"},
+		FinalizedCheckpointHandler: func(ctx context.Context, event *apiv1.FinalizedCheckpointEvent) {
+			s.handleFinalizedCheckpoint(ctx, address, event)
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create events provider")
+	}
+
+	return nil
+}
+
+func (s *Service) handleFinalizedCheckpoint(ctx context.Context,
+	address string,
+	event *apiv1.FinalizedCheckpointEvent,
+) {
+	// Finality is reported as part of the epoch transition, so the delay
+	// is measured from the start of the epoch in which it is observed.
+	observedEpoch := s.chainTime.CurrentEpoch()
+	delay := time.Since(s.chainTime.StartOfEpoch(observedEpoch))
+
+	s.observedEpochsMu.Lock()
+	s.observedEpochs[address] = observedEpoch
+	s.observedEpochsMu.Unlock()
+
+	// Ensure the node is in a state to provide useful information.
+	if !s.nodeStatus.Submittable(address) {
+		log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+		monitorEventIgnored()
+		return
+	}
+
+	// With full participation an epoch is finalized at the start of the
+	// epoch two after it; anything later, or slow to arrive, is late.
+	late := delay > s.lateThreshold || observedEpoch > event.Epoch+2
+	if late {
+		log.Debug().
+			Str("address", address).
+			Uint64("epoch", uint64(event.Epoch)).
+			Uint64("observed_epoch", uint64(observedEpoch)).
+			Stringer("delay", delay).
+			Msg("Finality is late")
+	}
+	monitorEventProcessed(address, event.Epoch, delay, late)
+
+	s.submitter.SubmitFinalityDelay(ctx, &submitter.FinalityDelay{
+		Source:        s.nodeStatus.Status(address).Version,
+		Method:        "finalized checkpoint event",
+		Epoch:         event.Epoch,
+		Block:         event.Block,
+		State:         event.State,
+		ObservedEpoch: observedEpoch,
+		Late:          late,
+		Delay:         delay,
+	})
+}
+
+// checkMissing checks each epoch for nodes that have not reported finality.
+func (s *Service) checkMissing(ctx context.Context) {
+	// Start with the next epoch, as events for the current epoch may have
+	// been sent before we subscribed.
+	epoch := s.chainTime.CurrentEpoch() + 1
+	for {
+		timer := time.NewTimer(time.Until(s.chainTime.StartOfEpoch(epoch).Add(s.missingThreshold)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.checkMissingForEpoch(ctx, epoch)
+		epoch++
+	}
+}
+
+// checkMissingForEpoch flags nodes that have not reported finality in the given epoch.
+func (s *Service) checkMissingForEpoch(ctx context.Context, epoch phase0.Epoch) {
+	missing := make([]string, 0)
+	s.observedEpochsMu.Lock()
+	for _, address := range s.addresses {
+		if !s.nodeStatus.Submittable(address) {
+			continue
+		}
+		if observedEpoch, exists := s.observedEpochs[address]; exists && observedEpoch >= epoch {
+			continue
+		}
+		missing = append(missing, address)
+	}
+	s.observedEpochsMu.Unlock()
+
+	// With full participation the epoch two before this one would have been finalized.
+	expectedEpoch := phase0.Epoch(0)
+	if epoch > 2 {
+		expectedEpoch = epoch - 2
+	}
+	for _, address := range missing {
+		log.Warn().Str("address", address).Uint64("epoch", uint64(epoch)).Msg("Finality not reported")
+		monitorFinalityMissing(address)
+
+		s.submitter.SubmitFinalityDelay(ctx, &submitter.FinalityDelay{
+			Source:        s.nodeStatus.Status(address).Version,
+			Method:        "finality missing",
+			Epoch:         expectedEpoch,
+			ObservedEpoch: epoch,
+			Late:          true,
+			Missing:       true,
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -25,6 +25,7 @@ var (
 	delayTimer      prometheus.Histogram
 	latestTimestamp prometheus.Gauge
 	eventsReceived  prometheus.Counter
+	eventsIgnored   prometheus.Counter
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -84,8 +85,18 @@ func registerPrometheusMetrics(_ context.Context) error {
 		Name:      "events_total",
 		Help:      "The number of head events received.",
 	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "heads",
+		Name:      "events_ignored_total",
+		Help:      "The number of head events ignored because their node was not in a submittable state.",
+	})
 
-	return prometheus.Register(eventsReceived)
+	return prometheus.Register(eventsIgnored)
 }
 
 // monitorEventSeen is called when a block event has been seen.
@@ -98,3 +109,12 @@ func monitorEventProcessed(delay time.Duration) {
 	eventsReceived.Inc()
 	delayTimer.Observe(delay.Seconds())
 }
+
+// monitorEventIgnored is called when a head event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,7 +15,6 @@ package events
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	consensusclient "github.com/attestantio/go-eth2-client"
@@ -25,13 +24,17 @@ import (
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
 	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
 	"github.com/wealdtech/probec/services/submitter"
 )
 
 // Service is a fee recipient provider service.
 type Service struct {
-	chainTime chaintime.Service
-	submitter submitter.Service
+	chainTime  chaintime.Service
+	submitter  submitter.Service
+	streams    streams.Service
+	nodeStatus nodestatus.Service
 }
 
 // module-wide log.
@@ -55,12 +58,14 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	}
 
 	s := &Service{
-		chainTime: parameters.chainTime,
-		submitter: parameters.submitter,
+		chainTime:  parameters.chainTime,
+		submitter:  parameters.submitter,
+		streams:    parameters.streams,
+		nodeStatus: parameters.nodeStatus,
 	}
 
 	for address, eventsProvider := range parameters.eventsProviders {
-		if err := s.monitorEvents(ctx, eventsProvider, parameters.nodeVersionProviders[address]); err != nil {
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
 			return nil, err
 		}
 	}
@@ -69,45 +74,29 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 }
 
 func (s *Service) monitorEvents(ctx context.Context,
+	address string,
 	eventsProvider consensusclient.EventsProvider,
-	nodeVersionProvider consensusclient.NodeVersionProvider,
 ) error {
-	if err := eventsProvider.Events(ctx, &api.EventsOpts{
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
 		Topics: []string{"head"},
 		HeadHandler: func(ctx context.Context, event *apiv1.HeadEvent) {
 			delay := time.Since(s.chainTime.StartOfSlot(event.Slot))
 
-			// Ensure the node is synced.
-			syncingProvider, ok := eventsProvider.(consensusclient.NodeSyncingProvider)
-			if !ok {
-				log.Error().Msg("Node syncing provider not supported")
+			// Ensure the node is in a state to provide useful information.
+			if !s.nodeStatus.Submittable(address) {
+				log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+				monitorEventIgnored()
 				return
 			}
-			syncingResponse, err := syncingProvider.NodeSyncing(ctx, &api.NodeSyncingOpts{})
-			if err != nil {
-				log.Error().Err(err).Msg("Failed to ascertain if node is syncing")
-				return
-			}
-			if syncingResponse.Data.IsSyncing {
-				log.Debug().Msg("Node is syncing, not sending information")
-			}
 
 			monitorEventProcessed(delay)
 
-			nodeVersionResponse, err := nodeVersionProvider.NodeVersion(ctx, &api.NodeVersionOpts{})
-			if err != nil {
-				log.Error().Err(err).Msg("Failed to obtain node version")
-				return
-			}
-
-			// Build and send the data.
-			body := fmt.Sprintf(
-				`{"source":"%s","method":"head event","slot":"%d","delay_ms":"%d"}`,
-				nodeVersionResponse.Data,
-				event.Slot,
-				int(delay.Milliseconds()),
-			)
-			s.submitter.SubmitHeadDelay(ctx, body)
+			s.submitter.SubmitHeadDelay(ctx, &submitter.HeadDelay{
+				Source: s.nodeStatus.Status(address).Version,
+				Method: "head event",
+				Slot:   event.Slot,
+				Delay:  delay,
+			})
 		},
 	}); err != nil {
 		return errors.Wrap(err, "failed to create events provider")
@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -21,8 +21,10 @@ import (
 	"github.com/attestantio/go-eth2-client/mock"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
-	"github.com/wealdtech/probec/services/blocks/events"
 	standardchaintime "github.com/wealdtech/probec/services/chaintime/standard"
+	"github.com/wealdtech/probec/services/heads/events"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	mockstreams "github.com/wealdtech/probec/services/streams/mock"
 	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
 )
 
@@ -40,6 +42,8 @@ func TestService(t *testing.T) {
 	require.NoError(t, err)
 
 	submitter := mocksubmitter.New()
+	nodeStatus := mocknodestatus.New()
+	streams := mockstreams.New()
 
 	tests := []struct {
 		name   string
@@ -55,10 +59,9 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
-					"test": mockClient,
-				}),
+				events.WithNodeStatus(nodeStatus),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
 			},
 			err: "problem with parameters: monitor not supplied",
 		},
@@ -70,9 +73,8 @@ func TestService(t *testing.T) {
 					"test": mockClient,
 				}),
 				events.WithSubmitter(submitter),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
-					"test": mockClient,
-				}),
+				events.WithStreams(streams),
+				events.WithNodeStatus(nodeStatus),
 			},
 			err: "problem with parameters: chain time service not supplied",
 		},
@@ -82,15 +84,14 @@ func TestService(t *testing.T) {
 				events.WithLogLevel(zerolog.Disabled),
 				events.WithChainTime(chainTime),
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
-					"test": mockClient,
-				}),
+				events.WithNodeStatus(nodeStatus),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
 			},
 			err: "problem with parameters: events providers not supplied",
 		},
 		{
-			name: "NodeVersionProvidersMissing",
+			name: "NodeStatusMissing",
 			params: []events.Parameter{
 				events.WithLogLevel(zerolog.Disabled),
 				events.WithChainTime(chainTime),
@@ -98,8 +99,9 @@ func TestService(t *testing.T) {
 					"test": mockClient,
 				}),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
 			},
-			err: "problem with parameters: node version providers not supplied",
+			err: "problem with parameters: node status service not supplied",
 		},
 		{
 			name: "SubmitterMissing",
@@ -109,24 +111,35 @@ func TestService(t *testing.T) {
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
-					"test": mockClient,
-				}),
+				events.WithNodeStatus(nodeStatus),
+				events.WithStreams(streams),
 			},
 			err: "problem with parameters: submitter not supplied",
 		},
 		{
-			name: "Good",
+			name: "StreamsMissing",
 			params: []events.Parameter{
 				events.WithLogLevel(zerolog.Disabled),
 				events.WithChainTime(chainTime),
 				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
-				events.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{
+				events.WithNodeStatus(nodeStatus),
+				events.WithSubmitter(submitter),
+			},
+			err: "problem with parameters: streams service not supplied",
+		},
+		{
+			name: "Good",
+			params: []events.Parameter{
+				events.WithLogLevel(zerolog.Disabled),
+				events.WithChainTime(chainTime),
+				events.WithEventsProviders(map[string]consensusclient.EventsProvider{
 					"test": mockClient,
 				}),
+				events.WithNodeStatus(nodeStatus),
 				events.WithSubmitter(submitter),
+				events.WithStreams(streams),
 			},
 		},
 	}
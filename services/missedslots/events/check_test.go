@@ -0,0 +1,147 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	mockchaintime "github.com/wealdtech/probec/services/chaintime/mock"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	"github.com/wealdtech/probec/services/submitter"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+func TestCheckSlot(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := mockchaintime.NewStandard(time.Now(), 12*time.Second)
+	require.NoError(t, err)
+
+	canonical := phase0.Root{0x01}
+	orphaned := phase0.Root{0x02}
+	reorged := phase0.Root{0x03}
+
+	recorder := mocksubmitter.NewRecorder()
+	s := &Service{
+		chainTime:  chainTime,
+		submitter:  recorder,
+		nodeStatus: mocknodestatus.New(),
+		addresses:  []string{"a", "b"},
+		startSlot:  10,
+		blocks:     make(map[phase0.Slot]map[phase0.Root]map[string]struct{}),
+		heads:      make(map[phase0.Slot]map[phase0.Root]struct{}),
+		reorged:    make(map[phase0.Slot]map[phase0.Root]struct{}),
+		nodeHeads:  make(map[string]map[phase0.Slot]phase0.Root),
+	}
+
+	// Slot 9 is before the start slot, so is not checked.
+	s.checkSlot(ctx, 9)
+	require.Empty(t, recorder.SlotOutcomes())
+
+	// Slot 10 has no blocks.
+	s.checkSlot(ctx, 10)
+	outcomes := recorder.SlotOutcomes()
+	require.Len(t, outcomes, 1)
+	require.Equal(t, submitter.SlotOutcomeMissed, outcomes[0].Outcome)
+	require.Equal(t, phase0.Slot(10), outcomes[0].Slot)
+	require.Empty(t, outcomes[0].Seen)
+	require.Equal(t, []string{"a", "b"}, outcomes[0].Missing)
+	require.Equal(t, uint64(1), s.epochMissed)
+
+	// Slot 11 has a canonical block and a block that did not become the head.
+	s.recordBlock("a", 11, canonical)
+	s.recordBlock("b", 11, canonical)
+	s.recordHead("a", 11, canonical)
+	s.recordBlock("b", 11, orphaned)
+	s.checkSlot(ctx, 11)
+	outcomes = recorder.SlotOutcomes()
+	require.Len(t, outcomes, 2)
+	require.Equal(t, submitter.SlotOutcomeOrphaned, outcomes[1].Outcome)
+	require.Equal(t, orphaned, outcomes[1].BlockRoot)
+	require.Equal(t, []string{"b"}, outcomes[1].Seen)
+	require.Equal(t, []string{"a"}, outcomes[1].Missing)
+	require.Equal(t, uint64(1), s.epochMissed)
+
+	// Slot 12 has a block that became the head but was reorged out by slot 13.
+	s.recordBlock("a", 12, reorged)
+	s.recordHead("a", 12, reorged)
+	s.recordReorg("a", 13, 1, reorged, phase0.Root{0x04})
+	s.checkSlot(ctx, 12)
+	outcomes = recorder.SlotOutcomes()
+	require.Len(t, outcomes, 3)
+	require.Equal(t, submitter.SlotOutcomeOrphaned, outcomes[2].Outcome)
+	require.Equal(t, reorged, outcomes[2].BlockRoot)
+
+	// Checked slots are pruned.
+	require.Empty(t, s.blocks)
+	require.Empty(t, s.heads)
+	require.Len(t, s.reorged, 1)
+
+	// A reorg of checked slots does not recreate their data, even with a
+	// depth that reaches back to genesis.
+	s.recordReorg("a", 13, 1, reorged, phase0.Root{0x04})
+	s.recordReorg("a", 13, 1000, reorged, phase0.Root{0x04})
+	require.Equal(t, []phase0.Slot{13}, reorgedSlots(s))
+	s.recordReorg("a", 12, 1, reorged, phase0.Root{0x04})
+	require.Equal(t, []phase0.Slot{13}, reorgedSlots(s))
+}
+
+// reorgedSlots returns the slots with reorged blocks, in order.
+func reorgedSlots(s *Service) []phase0.Slot {
+	slots := make([]phase0.Slot, 0, len(s.reorged))
+	for slot := range s.reorged {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i] < slots[j] })
+
+	return slots
+}
+
+func TestRecordReorg(t *testing.T) {
+	s := &Service{
+		heads:     make(map[phase0.Slot]map[phase0.Root]struct{}),
+		reorged:   make(map[phase0.Slot]map[phase0.Root]struct{}),
+		nodeHeads: make(map[string]map[phase0.Slot]phase0.Root),
+	}
+
+	ancestor := phase0.Root{0x01}
+	orphan1 := phase0.Root{0x02}
+	orphan2 := phase0.Root{0x03}
+	newHead := phase0.Root{0x04}
+
+	// Node a follows a branch of two blocks on top of the common ancestor,
+	// before reorging to a new head at the same slot as the old head.
+	s.recordHead("a", 20, ancestor)
+	s.recordHead("a", 21, orphan1)
+	s.recordHead("a", 22, orphan2)
+	s.recordReorg("a", 22, 2, orphan2, newHead)
+	s.recordHead("a", 22, newHead)
+
+	// The whole branch is recorded as reorged, but not the ancestor or the new head.
+	require.Contains(t, s.reorged[21], orphan1)
+	require.Contains(t, s.reorged[22], orphan2)
+	require.NotContains(t, s.reorged[20], ancestor)
+	require.NotContains(t, s.reorged[22], newHead)
+	require.Equal(t, map[phase0.Slot]phase0.Root{20: ancestor, 22: newHead}, s.nodeHeads["a"])
+
+	// The old head is recorded against all slots it could be in.
+	for slot := phase0.Slot(20); slot <= 22; slot++ {
+		require.Contains(t, s.reorged[slot], orphan2)
+	}
+}
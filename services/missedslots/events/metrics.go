@@ -0,0 +1,140 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	missedSlots       prometheus.Counter
+	orphanedBlocks    prometheus.Counter
+	epochMissedSlots  prometheus.Gauge
+	latestCheckedSlot prometheus.Gauge
+	eventsReceived    *prometheus.CounterVec
+	eventsIgnored     prometheus.Counter
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if latestCheckedSlot != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	missedSlots = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "missedslots",
+		Name:      "missed_total",
+		Help:      "The number of slots for which no node saw a block.",
+	})
+	if err := prometheus.Register(missedSlots); err != nil {
+		return err
+	}
+
+	orphanedBlocks = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "missedslots",
+		Name:      "orphaned_total",
+		Help:      "The number of blocks that were seen but did not become the head.",
+	})
+	if err := prometheus.Register(orphanedBlocks); err != nil {
+		return err
+	}
+
+	epochMissedSlots = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "missedslots",
+		Name:      "epoch_missed",
+		Help:      "The number of slots checked so far in the current epoch for which no node saw a block.",
+	})
+	if err := prometheus.Register(epochMissedSlots); err != nil {
+		return err
+	}
+
+	latestCheckedSlot = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "missedslots",
+		Name:      "latest_checked_slot",
+		Help:      "The latest slot checked for a block.",
+	})
+	if err := prometheus.Register(latestCheckedSlot); err != nil {
+		return err
+	}
+
+	eventsReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "missedslots",
+		Name:      "events_total",
+		Help:      "The number of events received.",
+	}, []string{"topic"})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "missedslots",
+		Name:      "events_ignored_total",
+		Help:      "The number of events ignored because their node was not in a submittable state.",
+	})
+
+	return prometheus.Register(eventsIgnored)
+}
+
+// monitorEventProcessed is called when an event has been processed.
+func monitorEventProcessed(topic string) {
+	if eventsReceived == nil {
+		return
+	}
+
+	eventsReceived.WithLabelValues(topic).Inc()
+}
+
+// monitorEventIgnored is called when an event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
+
+// monitorSlotChecked is called when a slot has been checked.
+func monitorSlotChecked(slot phase0.Slot, epochMissed uint64, missed bool, orphaned int) {
+	if latestCheckedSlot == nil {
+		return
+	}
+
+	latestCheckedSlot.Set(float64(slot))
+	epochMissedSlots.Set(float64(epochMissed))
+	if missed {
+		missedSlots.Inc()
+	}
+	orphanedBlocks.Add(float64(orphaned))
+}
@@ -0,0 +1,144 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"errors"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/rs/zerolog"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/metrics"
+	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+type parameters struct {
+	logLevel        zerolog.Level
+	monitor         metrics.Service
+	chainTime       chaintime.Service
+	eventsProviders map[string]consensusclient.EventsProvider
+	nodeStatus      nodestatus.Service
+	submitter       submitter.Service
+	streams         streams.Service
+	checkOffset     time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the module.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithChainTime sets the chain time service for this module.
+func WithChainTime(service chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = service
+	})
+}
+
+// WithEventsProviders sets the events providers for this module.
+func WithEventsProviders(providers map[string]consensusclient.EventsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.eventsProviders = providers
+	})
+}
+
+// WithNodeStatus sets the node status service for this module.
+func WithNodeStatus(service nodestatus.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nodeStatus = service
+	})
+}
+
+// WithSubmitter sets the submitter for this module.
+func WithSubmitter(submitter submitter.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submitter = submitter
+	})
+}
+
+// WithStreams sets the streams service for this module.
+func WithStreams(service streams.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.streams = service
+	})
+}
+
+// WithCheckOffset sets the time after the end of the following slot at which a slot is checked for a block.
+func WithCheckOffset(offset time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.checkOffset = offset
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:    zerolog.GlobalLevel(),
+		monitor:     nullmetrics.New(),
+		checkOffset: 4 * time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.monitor == nil {
+		return nil, errors.New("monitor not supplied")
+	}
+	if parameters.chainTime == nil {
+		return nil, errors.New("chain time service not supplied")
+	}
+	if len(parameters.eventsProviders) == 0 {
+		return nil, errors.New("events providers not supplied")
+	}
+	if parameters.nodeStatus == nil {
+		return nil, errors.New("node status service not supplied")
+	}
+	if parameters.submitter == nil {
+		return nil, errors.New("submitter not supplied")
+	}
+	if parameters.streams == nil {
+		return nil, errors.New("streams service not supplied")
+	}
+	if parameters.checkOffset < 0 {
+		return nil, errors.New("check offset cannot be negative")
+	}
+
+	return &parameters, nil
+}
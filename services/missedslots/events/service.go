@@ -0,0 +1,381 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// Service is a missed slot monitoring service.
+type Service struct {
+	chainTime   chaintime.Service
+	submitter   submitter.Service
+	streams     streams.Service
+	nodeStatus  nodestatus.Service
+	addresses   []string
+	checkOffset time.Duration
+	// startSlot is the first slot that is checked, as the service may
+	// have missed the events for earlier slots.
+	startSlot phase0.Slot
+	// retainFrom is the first slot for which data is retained; data for
+	// earlier slots has been pruned once checked.
+	retainFrom phase0.Slot
+
+	// blocks are the nodes that saw each block, keyed by slot and root.
+	// heads are the blocks that became the head of at least one node, and
+	// reorged are the blocks that were removed from the chain of at least one node.
+	// nodeHeads are the heads of each node, from which the blocks on an
+	// orphaned branch are found.
+	blocksMu  sync.Mutex
+	blocks    map[phase0.Slot]map[phase0.Root]map[string]struct{}
+	heads     map[phase0.Slot]map[phase0.Root]struct{}
+	reorged   map[phase0.Slot]map[phase0.Root]struct{}
+	nodeHeads map[string]map[phase0.Slot]phase0.Root
+
+	// epoch and epochMissed are the number of missed slots in the epoch
+	// being checked; they are only accessed by the checker.
+	epoch       phase0.Epoch
+	epochMissed uint64
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new missed slot monitoring service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "missedslots").Str("impl", "events").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:   parameters.chainTime,
+		submitter:   parameters.submitter,
+		streams:     parameters.streams,
+		nodeStatus:  parameters.nodeStatus,
+		addresses:   make([]string, 0, len(parameters.eventsProviders)),
+		checkOffset: parameters.checkOffset,
+		startSlot:   parameters.chainTime.CurrentSlot() + 1,
+		retainFrom:  parameters.chainTime.CurrentSlot() + 1,
+		blocks:      make(map[phase0.Slot]map[phase0.Root]map[string]struct{}),
+		heads:       make(map[phase0.Slot]map[phase0.Root]struct{}),
+		reorged:     make(map[phase0.Slot]map[phase0.Root]struct{}),
+		nodeHeads:   make(map[string]map[phase0.Slot]phase0.Root),
+	}
+	// A check offset of a slot or more would overlap with the check for the following slot.
+	if s.checkOffset >= s.chainTime.SlotDuration() {
+		return nil, errors.New("check offset must be less than the slot duration")
+	}
+	s.epoch = s.chainTime.SlotToEpoch(s.startSlot)
+
+	for address, eventsProvider := range parameters.eventsProviders {
+		s.addresses = append(s.addresses, address)
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(s.addresses)
+
+	go s.checkSlots(ctx)
+
+	return s, nil
+}
+
+func (s *Service) monitorEvents(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+) error {
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: []string{"block", "head", "chain_reorg"},
+		BlockHandler: func(_ context.Context, event *apiv1.BlockEvent) {
+			if !s.acceptEvent(address, "block") {
+				return
+			}
+			s.recordBlock(address, event.Slot, event.Block)
+		},
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			if !s.acceptEvent(address, "head") {
+				return
+			}
+			s.recordHead(address, event.Slot, event.Block)
+		},
+		ChainReorgHandler: func(_ context.Context, event *apiv1.ChainReorgEvent) {
+			if !s.acceptEvent(address, "chain reorg") {
+				return
+			}
+			s.recordReorg(address, event.Slot, event.Depth, event.OldHeadBlock, event.NewHeadBlock)
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create events provider")
+	}
+
+	return nil
+}
+
+// acceptEvent returns true if the event from the given node should be processed.
+func (s *Service) acceptEvent(address string, topic string) bool {
+	// Ensure the node is in a state to provide useful information.
+	if !s.nodeStatus.Submittable(address) {
+		log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+		monitorEventIgnored()
+		return false
+	}
+
+	monitorEventProcessed(topic)
+
+	return true
+}
+
+// recordBlock records the sighting of a block by a node.
+func (s *Service) recordBlock(address string, slot phase0.Slot, root phase0.Root) {
+	s.blocksMu.Lock()
+	defer s.blocksMu.Unlock()
+
+	slotBlocks, exists := s.blocks[slot]
+	if !exists {
+		slotBlocks = make(map[phase0.Root]map[string]struct{})
+		s.blocks[slot] = slotBlocks
+	}
+	nodes, exists := slotBlocks[root]
+	if !exists {
+		nodes = make(map[string]struct{})
+		slotBlocks[root] = nodes
+	}
+	nodes[address] = struct{}{}
+}
+
+// recordHead records a block becoming the head of a node.
+func (s *Service) recordHead(address string, slot phase0.Slot, root phase0.Root) {
+	s.blocksMu.Lock()
+	defer s.blocksMu.Unlock()
+
+	if _, exists := s.heads[slot]; !exists {
+		s.heads[slot] = make(map[phase0.Root]struct{})
+	}
+	s.heads[slot][root] = struct{}{}
+
+	if _, exists := s.nodeHeads[address]; !exists {
+		s.nodeHeads[address] = make(map[phase0.Slot]phase0.Root)
+	}
+	s.nodeHeads[address][slot] = root
+}
+
+// recordReorg records the blocks on the branch removed from the chain of a node.
+func (s *Service) recordReorg(address string,
+	slot phase0.Slot,
+	depth uint64,
+	oldHead phase0.Root,
+	newHead phase0.Root,
+) {
+	s.blocksMu.Lock()
+	defer s.blocksMu.Unlock()
+
+	if slot < s.retainFrom {
+		// The slots affected by the reorg have already been checked.
+		return
+	}
+	ancestorSlot := phase0.Slot(0)
+	if uint64(slot) > depth {
+		ancestorSlot = slot - phase0.Slot(depth)
+	}
+
+	// The orphaned branch runs from the old head back to the common ancestor,
+	// so contains the heads of the node after the ancestor other than the new head.
+	for headSlot, root := range s.nodeHeads[address] {
+		if headSlot <= ancestorSlot || root == newHead {
+			continue
+		}
+		s.recordReorgedLocked(headSlot, root)
+		delete(s.nodeHeads[address], headSlot)
+	}
+
+	// The reorg event provides the slot of the new head, so the slot of the old
+	// head is not known.  Record the block against all slots it could be in, in
+	// case the node did not report it as its head.  Slots that have already been
+	// checked are skipped, which also bounds the walk for a malformed depth.
+	firstSlot := ancestorSlot
+	if firstSlot < s.retainFrom {
+		firstSlot = s.retainFrom
+	}
+	for reorgSlot := firstSlot; reorgSlot <= slot; reorgSlot++ {
+		s.recordReorgedLocked(reorgSlot, oldHead)
+	}
+}
+
+// recordReorgedLocked records a block as reorged; the caller must hold the lock.
+func (s *Service) recordReorgedLocked(slot phase0.Slot, root phase0.Root) {
+	if _, exists := s.reorged[slot]; !exists {
+		s.reorged[slot] = make(map[phase0.Root]struct{})
+	}
+	s.reorged[slot][root] = struct{}{}
+}
+
+// checkSlots checks each slot for a block once the check offset after the
+// end of the following slot has passed.  A block is usually orphaned by the
+// block for the following slot, so the check waits for that block to arrive.
+func (s *Service) checkSlots(ctx context.Context) {
+	for {
+		checkSlot := s.chainTime.TimestampToSlot(time.Now().Add(-s.checkOffset)) + 1
+		timer := time.NewTimer(time.Until(s.chainTime.StartOfSlot(checkSlot).Add(s.checkOffset)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if checkSlot >= 2 {
+			s.checkSlot(ctx, checkSlot-2)
+		}
+	}
+}
+
+// checkSlot checks a slot for a block, submitting the outcome if the
+// slot was missed or its block orphaned.
+func (s *Service) checkSlot(ctx context.Context, slot phase0.Slot) {
+	s.blocksMu.Lock()
+	blocks := s.blocks[slot]
+	heads := s.heads[slot]
+	reorged := s.reorged[slot]
+	s.pruneBefore(slot + 1)
+	s.blocksMu.Unlock()
+
+	if slot < s.startSlot {
+		return
+	}
+
+	// Nodes that are not in a submittable state have their events ignored,
+	// so cannot be used to decide if a block was seen.
+	submittable := make(map[string]struct{})
+	for _, address := range s.addresses {
+		if s.nodeStatus.Submittable(address) {
+			submittable[address] = struct{}{}
+		}
+	}
+	if len(submittable) == 0 {
+		log.Debug().Uint64("slot", uint64(slot)).Msg("No nodes in a submittable state, not checking slot")
+		return
+	}
+
+	if epoch := s.chainTime.SlotToEpoch(slot); epoch != s.epoch {
+		s.epoch = epoch
+		s.epochMissed = 0
+	}
+
+	missed := len(blocks) == 0
+	if missed {
+		s.epochMissed++
+		outcome := s.slotOutcome(slot, submitter.SlotOutcomeMissed, phase0.Root{}, nil, submittable)
+		log.Trace().Stringer("data", outcome).Msg("Missed slot")
+		s.submitter.SubmitSlotOutcome(ctx, outcome)
+	}
+
+	orphaned := 0
+	for root, nodes := range blocks {
+		_, isHead := heads[root]
+		_, isReorged := reorged[root]
+		if isHead && !isReorged {
+			continue
+		}
+		orphaned++
+		outcome := s.slotOutcome(slot, submitter.SlotOutcomeOrphaned, root, nodes, submittable)
+		log.Trace().Stringer("data", outcome).Msg("Orphaned block")
+		s.submitter.SubmitSlotOutcome(ctx, outcome)
+	}
+
+	monitorSlotChecked(slot, s.epochMissed, missed, orphaned)
+}
+
+// slotOutcome builds the outcome for a slot.
+func (s *Service) slotOutcome(slot phase0.Slot,
+	outcome string,
+	root phase0.Root,
+	nodes map[string]struct{},
+	submittable map[string]struct{},
+) *submitter.SlotOutcome {
+	data := &submitter.SlotOutcome{
+		Method:    "slot check",
+		Slot:      slot,
+		Outcome:   outcome,
+		BlockRoot: root,
+		Seen:      make([]string, 0, len(nodes)),
+		Missing:   make([]string, 0),
+	}
+	for _, address := range s.addresses {
+		if _, exists := nodes[address]; exists {
+			data.Seen = append(data.Seen, address)
+			continue
+		}
+		if _, exists := submittable[address]; exists {
+			data.Missing = append(data.Missing, address)
+		}
+	}
+
+	return data
+}
+
+// pruneBefore removes the data for slots before the given slot.
+// This assumes that the blocks lock is held.
+func (s *Service) pruneBefore(slot phase0.Slot) {
+	if slot > s.retainFrom {
+		s.retainFrom = slot
+	}
+	for blockSlot := range s.blocks {
+		if blockSlot < slot {
+			delete(s.blocks, blockSlot)
+		}
+	}
+	for headSlot := range s.heads {
+		if headSlot < slot {
+			delete(s.heads, headSlot)
+		}
+	}
+	for reorgSlot := range s.reorged {
+		if reorgSlot < slot {
+			delete(s.reorged, reorgSlot)
+		}
+	}
+	for _, heads := range s.nodeHeads {
+		for headSlot := range heads {
+			if headSlot < slot {
+				delete(heads, headSlot)
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"time"
+
+	"github.com/wealdtech/probec/services/nodestatus"
+)
+
+// service is a mock node status service.
+type service struct{}
+
+// New creates a new mock node status service.
+func New() nodestatus.Service {
+	return &service{}
+}
+
+// Status returns the latest status of the node at the given address.
+func (*service) Status(_ string) *nodestatus.Status {
+	return &nodestatus.Status{
+		Version: "mock",
+		Updated: time.Now(),
+	}
+}
+
+// Submittable returns true if data from the node at the given address should be submitted.
+func (*service) Submittable(_ string) bool {
+	return true
+}
@@ -0,0 +1,47 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodestatus
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Status is the status of a beacon node.
+type Status struct {
+	// Version is the version string reported by the node.
+	Version string
+	// Syncing is true if the node is syncing.
+	Syncing bool
+	// Optimistic is true if the node is optimistic.
+	Optimistic bool
+	// HeadSlot is the head slot of the node.
+	HeadSlot phase0.Slot
+	// SyncDistance is the distance between the node's highest synced slot and the head slot.
+	SyncDistance phase0.Slot
+	// Updated is the time at which the status was last updated.
+	Updated time.Time
+}
+
+// Service tracks the status of beacon nodes.
+type Service interface {
+	// Status returns the latest status of the node at the given address,
+	// or nil if the status is not known.
+	Status(address string) *Status
+
+	// Submittable returns true if data from the node at the given address
+	// should be submitted.
+	Submittable(address string) bool
+}
@@ -0,0 +1,149 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+	"github.com/wealdtech/probec/services/nodestatus"
+)
+
+var (
+	nodeSyncing      *prometheus.GaugeVec
+	nodeOptimistic   *prometheus.GaugeVec
+	nodeSyncDistance *prometheus.GaugeVec
+	nodeHeadSlot     *prometheus.GaugeVec
+	nodeInfo         *prometheus.GaugeVec
+	pollFailures     *prometheus.CounterVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if nodeSyncing != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	nodeSyncing = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "node",
+		Name:      "syncing",
+		Help:      "1 if the node is syncing, otherwise 0.",
+	}, []string{"address"})
+	if err := prometheus.Register(nodeSyncing); err != nil {
+		return err
+	}
+
+	nodeOptimistic = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "node",
+		Name:      "optimistic",
+		Help:      "1 if the node is optimistic, otherwise 0.",
+	}, []string{"address"})
+	if err := prometheus.Register(nodeOptimistic); err != nil {
+		return err
+	}
+
+	nodeSyncDistance = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "node",
+		Name:      "sync_distance",
+		Help:      "The sync distance of the node, in slots.",
+	}, []string{"address"})
+	if err := prometheus.Register(nodeSyncDistance); err != nil {
+		return err
+	}
+
+	nodeHeadSlot = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "node",
+		Name:      "head_slot",
+		Help:      "The head slot of the node.",
+	}, []string{"address"})
+	if err := prometheus.Register(nodeHeadSlot); err != nil {
+		return err
+	}
+
+	nodeInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "node",
+		Name:      "info",
+		Help:      "Information about the node.",
+	}, []string{"address", "version"})
+	if err := prometheus.Register(nodeInfo); err != nil {
+		return err
+	}
+
+	pollFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "node",
+		Name:      "poll_failures_total",
+		Help:      "The number of failed polls of node status.",
+	}, []string{"address", "request"})
+
+	return prometheus.Register(pollFailures)
+}
+
+// monitorNodeSyncState is called when the sync state of a node is obtained.
+func monitorNodeSyncState(address string, status *nodestatus.Status) {
+	if nodeSyncing == nil {
+		return
+	}
+
+	nodeSyncing.WithLabelValues(address).Set(boolToFloat(status.Syncing))
+	nodeOptimistic.WithLabelValues(address).Set(boolToFloat(status.Optimistic))
+	nodeSyncDistance.WithLabelValues(address).Set(float64(status.SyncDistance))
+	nodeHeadSlot.WithLabelValues(address).Set(float64(status.HeadSlot))
+}
+
+// monitorNodeVersion is called when the version of a node changes.
+func monitorNodeVersion(address string, oldVersion string, newVersion string) {
+	if nodeInfo == nil {
+		return
+	}
+
+	if oldVersion != "" {
+		nodeInfo.DeleteLabelValues(address, oldVersion)
+	}
+	nodeInfo.WithLabelValues(address, newVersion).Set(1)
+}
+
+// monitorPollFailure is called when a poll of node status fails.
+func monitorPollFailure(address string, request string) {
+	if pollFailures == nil {
+		return
+	}
+
+	pollFailures.WithLabelValues(address, request).Inc()
+}
+
+func boolToFloat(input bool) float64 {
+	if input {
+		return 1
+	}
+
+	return 0
+}
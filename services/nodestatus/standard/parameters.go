@@ -0,0 +1,150 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/rs/zerolog"
+	"github.com/wealdtech/probec/services/metrics"
+	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
+)
+
+// OptimisticPolicy defines how data from optimistic nodes is treated.
+type OptimisticPolicy string
+
+const (
+	// OptimisticAllow submits data from optimistic nodes.
+	OptimisticAllow OptimisticPolicy = "allow"
+	// OptimisticReject does not submit data from optimistic nodes.
+	OptimisticReject OptimisticPolicy = "reject"
+)
+
+type parameters struct {
+	logLevel             zerolog.Level
+	monitor              metrics.Service
+	nodeSyncingProviders map[string]consensusclient.NodeSyncingProvider
+	nodeVersionProviders map[string]consensusclient.NodeVersionProvider
+	pollInterval         time.Duration
+	optimisticPolicy     OptimisticPolicy
+	maxStatusAge         time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the module.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithNodeSyncingProviders sets the node syncing providers, keyed by address.
+func WithNodeSyncingProviders(providers map[string]consensusclient.NodeSyncingProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nodeSyncingProviders = providers
+	})
+}
+
+// WithNodeVersionProviders sets the node version providers, keyed by address.
+func WithNodeVersionProviders(providers map[string]consensusclient.NodeVersionProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nodeVersionProviders = providers
+	})
+}
+
+// WithPollInterval sets the interval between polls of node status.
+func WithPollInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.pollInterval = interval
+	})
+}
+
+// WithOptimisticPolicy sets the policy for data from optimistic nodes.
+func WithOptimisticPolicy(policy OptimisticPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.optimisticPolicy = policy
+	})
+}
+
+// WithMaxStatusAge sets the maximum age of a status for data from its
+// node to be submitted.  If 0 then the age of the status is not checked.
+func WithMaxStatusAge(age time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxStatusAge = age
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:         zerolog.GlobalLevel(),
+		monitor:          nullmetrics.New(),
+		pollInterval:     12 * time.Second,
+		optimisticPolicy: OptimisticReject,
+		maxStatusAge:     time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.monitor == nil {
+		return nil, errors.New("monitor not supplied")
+	}
+	if len(parameters.nodeSyncingProviders) == 0 {
+		return nil, errors.New("node syncing providers not supplied")
+	}
+	if len(parameters.nodeVersionProviders) == 0 {
+		return nil, errors.New("node version providers not supplied")
+	}
+	for address := range parameters.nodeSyncingProviders {
+		if _, exists := parameters.nodeVersionProviders[address]; !exists {
+			return nil, fmt.Errorf("node version provider not supplied for %s", address)
+		}
+	}
+	if parameters.pollInterval <= 0 {
+		return nil, errors.New("poll interval must be greater than 0")
+	}
+	switch parameters.optimisticPolicy {
+	case OptimisticAllow, OptimisticReject:
+	default:
+		return nil, fmt.Errorf("unknown optimistic policy %s", parameters.optimisticPolicy)
+	}
+	if parameters.maxStatusAge < 0 {
+		return nil, errors.New("max status age cannot be negative")
+	}
+
+	return &parameters, nil
+}
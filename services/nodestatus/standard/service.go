@@ -0,0 +1,208 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/nodestatus"
+)
+
+// Service is a service that tracks the status of beacon nodes by polling them.
+type Service struct {
+	nodeSyncingProviders map[string]consensusclient.NodeSyncingProvider
+	nodeVersionProviders map[string]consensusclient.NodeVersionProvider
+	pollInterval         time.Duration
+	optimisticPolicy     OptimisticPolicy
+	maxStatusAge         time.Duration
+	// log is captured at creation so that the service does not read the
+	// module-wide log, which is replaced by later calls to New.
+	log zerolog.Logger
+
+	statusesMu sync.RWMutex
+	statuses   map[string]*nodestatus.Status
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new node status service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "nodestatus").Str("impl", "standard").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		nodeSyncingProviders: parameters.nodeSyncingProviders,
+		nodeVersionProviders: parameters.nodeVersionProviders,
+		pollInterval:         parameters.pollInterval,
+		optimisticPolicy:     parameters.optimisticPolicy,
+		maxStatusAge:         parameters.maxStatusAge,
+		log:                  log,
+		statuses:             make(map[string]*nodestatus.Status),
+	}
+
+	// Obtain initial status synchronously, so that it is available to
+	// other services from the start.  This is bounded by the poll interval
+	// so that an unresponsive node does not hold up startup; its status
+	// will be obtained by later polls.
+	initialCtx, cancel := context.WithTimeout(ctx, s.pollInterval)
+	var wg sync.WaitGroup
+	for address := range s.nodeSyncingProviders {
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			s.poll(initialCtx, address)
+		}(address)
+	}
+	wg.Wait()
+	cancel()
+
+	for address := range s.nodeSyncingProviders {
+		go s.track(ctx, address)
+	}
+
+	return s, nil
+}
+
+// Status returns the latest status of the node at the given address,
+// or nil if the status is not known.
+func (s *Service) Status(address string) *nodestatus.Status {
+	s.statusesMu.RLock()
+	defer s.statusesMu.RUnlock()
+
+	status, exists := s.statuses[address]
+	if !exists {
+		return nil
+	}
+	// Return a copy, to avoid races with updates.
+	statusCopy := *status
+
+	return &statusCopy
+}
+
+// Submittable returns true if data from the node at the given address
+// should be submitted.
+func (s *Service) Submittable(address string) bool {
+	status := s.Status(address)
+	switch {
+	case status == nil:
+		s.log.Trace().Str("address", address).Msg("Node status not known")
+		return false
+	case s.maxStatusAge > 0 && time.Since(status.Updated) > s.maxStatusAge:
+		s.log.Trace().Str("address", address).Time("updated", status.Updated).Msg("Node status out of date")
+		return false
+	case status.Version == "":
+		// Data points require the version as their source.
+		s.log.Trace().Str("address", address).Msg("Node version not known")
+		return false
+	case status.Syncing:
+		s.log.Trace().Str("address", address).Msg("Node is syncing")
+		return false
+	case status.Optimistic && s.optimisticPolicy == OptimisticReject:
+		s.log.Trace().Str("address", address).Msg("Node is optimistic")
+		return false
+	default:
+		return true
+	}
+}
+
+// track polls the status of the node at the given address until the context is cancelled.
+func (s *Service) track(ctx context.Context, address string) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Select chooses randomly between ready cases, so check that
+			// the context has not been cancelled in the meantime.
+			if ctx.Err() != nil {
+				return
+			}
+			s.poll(ctx, address)
+		}
+	}
+}
+
+// poll polls the status of the node at the given address.
+func (s *Service) poll(ctx context.Context, address string) {
+	log := s.log.With().Str("address", address).Logger()
+
+	s.statusesMu.RLock()
+	previous, exists := s.statuses[address]
+	s.statusesMu.RUnlock()
+	status := &nodestatus.Status{}
+	if exists {
+		*status = *previous
+	}
+
+	versionResponse, err := s.nodeVersionProviders[address].NodeVersion(ctx, &api.NodeVersionOpts{})
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to obtain node version")
+		monitorPollFailure(address, "version")
+	} else {
+		if status.Version != versionResponse.Data {
+			log.Debug().Str("version", versionResponse.Data).Msg("Node version updated")
+			monitorNodeVersion(address, status.Version, versionResponse.Data)
+		}
+		status.Version = versionResponse.Data
+	}
+
+	syncingResponse, err := s.nodeSyncingProviders[address].NodeSyncing(ctx, &api.NodeSyncingOpts{})
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to obtain node syncing state")
+		monitorPollFailure(address, "syncing")
+	} else {
+		if syncingResponse.Data.IsSyncing != status.Syncing || !exists {
+			log.Debug().Bool("syncing", syncingResponse.Data.IsSyncing).Msg("Node syncing state updated")
+		}
+		status.Syncing = syncingResponse.Data.IsSyncing
+		status.Optimistic = syncingResponse.Data.IsOptimistic
+		status.HeadSlot = syncingResponse.Data.HeadSlot
+		status.SyncDistance = syncingResponse.Data.SyncDistance
+		// Only the syncing state determines if the status is up to date.
+		status.Updated = time.Now()
+		monitorNodeSyncState(address, status)
+	}
+
+	if status.Updated.IsZero() {
+		// We have never obtained the syncing state, so do not record a status.
+		return
+	}
+
+	s.statusesMu.Lock()
+	s.statuses[address] = status
+	s.statusesMu.Unlock()
+}
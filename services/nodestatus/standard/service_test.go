@@ -0,0 +1,344 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/nodestatus/standard"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	mockClient, err := mock.New(ctx)
+	require.NoError(t, err)
+
+	syncingProviders := map[string]consensusclient.NodeSyncingProvider{"test": mockClient}
+	versionProviders := map[string]consensusclient.NodeVersionProvider{"test": mockClient}
+
+	tests := []struct {
+		name   string
+		params []standard.Parameter
+		err    string
+	}{
+		{
+			name: "MonitorMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nil),
+				standard.WithNodeSyncingProviders(syncingProviders),
+				standard.WithNodeVersionProviders(versionProviders),
+			},
+			err: "problem with parameters: monitor not supplied",
+		},
+		{
+			name: "NodeSyncingProvidersMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithNodeVersionProviders(versionProviders),
+			},
+			err: "problem with parameters: node syncing providers not supplied",
+		},
+		{
+			name: "NodeVersionProvidersMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithNodeSyncingProviders(syncingProviders),
+			},
+			err: "problem with parameters: node version providers not supplied",
+		},
+		{
+			name: "NodeVersionProviderMismatch",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithNodeSyncingProviders(syncingProviders),
+				standard.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{"other": mockClient}),
+			},
+			err: "problem with parameters: node version provider not supplied for test",
+		},
+		{
+			name: "PollIntervalZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithNodeSyncingProviders(syncingProviders),
+				standard.WithNodeVersionProviders(versionProviders),
+				standard.WithPollInterval(0),
+			},
+			err: "problem with parameters: poll interval must be greater than 0",
+		},
+		{
+			name: "OptimisticPolicyUnknown",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithNodeSyncingProviders(syncingProviders),
+				standard.WithNodeVersionProviders(versionProviders),
+				standard.WithOptimisticPolicy("maybe"),
+			},
+			err: "problem with parameters: unknown optimistic policy maybe",
+		},
+		{
+			name: "MaxStatusAgeNegative",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithNodeSyncingProviders(syncingProviders),
+				standard.WithNodeVersionProviders(versionProviders),
+				standard.WithMaxStatusAge(-1),
+			},
+			err: "problem with parameters: max status age cannot be negative",
+		},
+		{
+			name: "Good",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithNodeSyncingProviders(syncingProviders),
+				standard.WithNodeVersionProviders(versionProviders),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			_, err := standard.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// syncStateClient is a mock client with a configurable sync state.
+type syncStateClient struct {
+	*mock.Service
+	mu          sync.Mutex
+	syncState   *apiv1.SyncState
+	fail        bool
+	versionFail bool
+}
+
+// newSyncStateClient creates a sync state client.  The mock client logs through
+// a package-level logger when its context is done, so it is created with a
+// context that is not cancelled to avoid racing with later tests.
+func newSyncStateClient(t *testing.T, syncState *apiv1.SyncState) *syncStateClient {
+	t.Helper()
+
+	mockClient, err := mock.New(context.Background())
+	require.NoError(t, err)
+	client := &syncStateClient{
+		Service:   mockClient,
+		syncState: syncState,
+	}
+	mockClient.NodeSyncingFunc = func(_ context.Context, _ *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error) {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		if client.fail {
+			return nil, errors.New("failed")
+		}
+		syncState := *client.syncState
+
+		return &api.Response[*apiv1.SyncState]{Data: &syncState}, nil
+	}
+	mockClient.NodeVersionFunc = func(_ context.Context, _ *api.NodeVersionOpts) (*api.Response[string], error) {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		if client.versionFail {
+			return nil, errors.New("failed")
+		}
+
+		return &api.Response[string]{Data: "mock"}, nil
+	}
+
+	return client
+}
+
+func (c *syncStateClient) set(syncState *apiv1.SyncState, fail bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.syncState = syncState
+	c.fail = fail
+}
+
+func (c *syncStateClient) setVersionFail(versionFail bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.versionFail = versionFail
+}
+
+func TestSubmittable(t *testing.T) {
+	tests := []struct {
+		name             string
+		syncState        *apiv1.SyncState
+		fail             bool
+		optimisticPolicy standard.OptimisticPolicy
+		submittable      bool
+	}{
+		{
+			name:        "Synced",
+			syncState:   &apiv1.SyncState{HeadSlot: 100},
+			submittable: true,
+		},
+		{
+			name:        "Syncing",
+			syncState:   &apiv1.SyncState{HeadSlot: 100, SyncDistance: 50, IsSyncing: true},
+			submittable: false,
+		},
+		{
+			name:             "OptimisticReject",
+			syncState:        &apiv1.SyncState{HeadSlot: 100, IsOptimistic: true},
+			optimisticPolicy: standard.OptimisticReject,
+			submittable:      false,
+		},
+		{
+			name:             "OptimisticAllow",
+			syncState:        &apiv1.SyncState{HeadSlot: 100, IsOptimistic: true},
+			optimisticPolicy: standard.OptimisticAllow,
+			submittable:      true,
+		},
+		{
+			name:        "Unavailable",
+			syncState:   &apiv1.SyncState{HeadSlot: 100},
+			fail:        true,
+			submittable: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			client := newSyncStateClient(t, test.syncState)
+			client.set(test.syncState, test.fail)
+
+			params := []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithNodeSyncingProviders(map[string]consensusclient.NodeSyncingProvider{"test": client}),
+				standard.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{"test": client}),
+			}
+			if test.optimisticPolicy != "" {
+				params = append(params, standard.WithOptimisticPolicy(test.optimisticPolicy))
+			}
+			s, err := standard.New(ctx, params...)
+			require.NoError(t, err)
+
+			require.Equal(t, test.submittable, s.Submittable("test"))
+			require.False(t, s.Submittable("unknown"))
+			if !test.fail {
+				status := s.Status("test")
+				require.NotNil(t, status)
+				require.Equal(t, test.syncState.IsSyncing, status.Syncing)
+				require.Equal(t, test.syncState.IsOptimistic, status.Optimistic)
+				require.Equal(t, test.syncState.HeadSlot, status.HeadSlot)
+			}
+		})
+	}
+}
+
+func TestStatusUpdates(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newSyncStateClient(t, &apiv1.SyncState{HeadSlot: 100, SyncDistance: 50, IsSyncing: true})
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithNodeSyncingProviders(map[string]consensusclient.NodeSyncingProvider{"test": client}),
+		standard.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{"test": client}),
+		standard.WithPollInterval(10*time.Millisecond),
+		standard.WithMaxStatusAge(100*time.Millisecond),
+	)
+	require.NoError(t, err)
+	require.False(t, s.Submittable("test"))
+
+	// Node finishes syncing.
+	client.set(&apiv1.SyncState{HeadSlot: 150}, false)
+	require.Eventually(t, func() bool { return s.Submittable("test") }, time.Second, 10*time.Millisecond)
+
+	// Node stops responding, so its status goes out of date.
+	client.set(&apiv1.SyncState{HeadSlot: 150}, true)
+	require.Eventually(t, func() bool { return !s.Submittable("test") }, time.Second, 10*time.Millisecond)
+}
+
+func TestVersionUnknown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := newSyncStateClient(t, &apiv1.SyncState{HeadSlot: 100})
+	client.setVersionFail(true)
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithNodeSyncingProviders(map[string]consensusclient.NodeSyncingProvider{"test": client}),
+		standard.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{"test": client}),
+		standard.WithPollInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// Node is synced, but without a version its data cannot be submitted.
+	status := s.Status("test")
+	require.NotNil(t, status)
+	require.Empty(t, status.Version)
+	require.False(t, s.Submittable("test"))
+
+	// Version is obtained on a later poll.
+	client.setVersionFail(false)
+	require.Eventually(t, func() bool { return s.Submittable("test") }, time.Second, 10*time.Millisecond)
+	require.Equal(t, "mock", s.Status("test").Version)
+}
+
+// unresponsiveClient is a client that does not respond until its context is done.
+type unresponsiveClient struct {
+	*mock.Service
+}
+
+func (*unresponsiveClient) NodeSyncing(ctx context.Context, _ *api.NodeSyncingOpts) (*api.Response[*apiv1.SyncState], error) {
+	<-ctx.Done()
+
+	return nil, ctx.Err()
+}
+
+func TestInitialPollBounded(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockClient, err := mock.New(context.Background())
+	require.NoError(t, err)
+	client := &unresponsiveClient{Service: mockClient}
+
+	started := time.Now()
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithNodeSyncingProviders(map[string]consensusclient.NodeSyncingProvider{"test": client}),
+		standard.WithNodeVersionProviders(map[string]consensusclient.NodeVersionProvider{"test": client}),
+		standard.WithPollInterval(50*time.Millisecond),
+	)
+	require.NoError(t, err)
+	require.Less(t, time.Since(started), time.Second)
+	require.False(t, s.Submittable("test"))
+}
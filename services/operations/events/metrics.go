@@ -0,0 +1,122 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	propagationTimer *prometheus.HistogramVec
+	latestTimestamp  prometheus.Gauge
+	eventsReceived   *prometheus.CounterVec
+	eventsIgnored    prometheus.Counter
+	operationsSeen   *prometheus.CounterVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if latestTimestamp != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	propagationTimer = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "operations",
+		Name:      "propagation_seconds",
+		Help:      "The time from an operation first being seen by any node to it being seen by another node.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8, 16, 32},
+	}, []string{"type"})
+	if err := prometheus.Register(propagationTimer); err != nil {
+		return err
+	}
+
+	latestTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "operations",
+		Name:      "latest_timestamp",
+		Help:      "The latest timestamp at which probec obtained an operation event.",
+	})
+	if err := prometheus.Register(latestTimestamp); err != nil {
+		return err
+	}
+
+	eventsReceived = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "operations",
+		Name:      "events_total",
+		Help:      "The number of operation events received.",
+	}, []string{"type"})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "operations",
+		Name:      "events_ignored_total",
+		Help:      "The number of operation events ignored because their node was not in a submittable state.",
+	})
+	if err := prometheus.Register(eventsIgnored); err != nil {
+		return err
+	}
+
+	operationsSeen = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "operations",
+		Name:      "operations_total",
+		Help:      "The number of distinct operations seen.",
+	}, []string{"type"})
+
+	return prometheus.Register(operationsSeen)
+}
+
+// monitorEventProcessed is called when an operation event has been processed.
+func monitorEventProcessed(operationType string, first bool, sinceFirst time.Duration) {
+	if latestTimestamp == nil {
+		return
+	}
+
+	latestTimestamp.SetToCurrentTime()
+	eventsReceived.WithLabelValues(operationType).Inc()
+	if first {
+		operationsSeen.WithLabelValues(operationType).Inc()
+	} else {
+		propagationTimer.WithLabelValues(operationType).Observe(sinceFirst.Seconds())
+	}
+}
+
+// monitorEventIgnored is called when an operation event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
@@ -0,0 +1,73 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+func TestFirstSeenSpread(t *testing.T) {
+	ctx := context.Background()
+
+	recorder := mocksubmitter.NewRecorder()
+	s := &Service{
+		submitter:  recorder,
+		nodeStatus: mocknodestatus.New(),
+		// Long enough that the summary is only submitted when requested by the test.
+		observationWindow: time.Hour,
+		operations:        make(map[phase0.Root]*operation),
+	}
+
+	exit := &phase0.SignedVoluntaryExit{
+		Message: &phase0.VoluntaryExit{
+			Epoch:          1,
+			ValidatorIndex: 2,
+		},
+	}
+	root, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+
+	// Node a sees the exit first, then node b, then node a again.
+	s.handleOperation(ctx, "a", typeVoluntaryExit, exit)
+	time.Sleep(10 * time.Millisecond)
+	s.handleOperation(ctx, "b", typeVoluntaryExit, exit)
+	time.Sleep(10 * time.Millisecond)
+	s.handleOperation(ctx, "a", typeVoluntaryExit, exit)
+
+	s.submitOperation(ctx, root)
+	require.Len(t, recorder.OperationSummaries(), 1)
+	summary := recorder.OperationSummaries()[0]
+	require.Equal(t, typeVoluntaryExit, summary.Type)
+	require.Equal(t, phase0.Root(root), summary.Root)
+	require.Len(t, summary.Sightings, 2)
+	require.Zero(t, summary.Sightings["a"].SinceFirst)
+	require.GreaterOrEqual(t, summary.Sightings["b"].SinceFirst, 10*time.Millisecond)
+
+	// A sighting after the observation window is not recorded, and the
+	// operation is not treated as new.
+	s.handleOperation(ctx, "c", typeVoluntaryExit, exit)
+	require.NotContains(t, s.operations[root].sightings, "c")
+	require.True(t, s.operations[root].submitted)
+
+	// Unknown operations are not submitted.
+	s.submitOperation(ctx, phase0.Root{0x01})
+	require.Len(t, recorder.OperationSummaries(), 1)
+}
@@ -0,0 +1,220 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// Operation types.
+const (
+	typeAttesterSlashing     = "attester_slashing"
+	typeProposerSlashing     = "proposer_slashing"
+	typeVoluntaryExit        = "voluntary_exit"
+	typeBLSToExecutionChange = "bls_to_execution_change"
+)
+
+// hashTreeRooter is implemented by all operations.
+type hashTreeRooter interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// operation contains the sightings of a single operation.
+type operation struct {
+	operationType string
+	firstSeen     time.Time
+	sightings     map[string]time.Time
+	submitted     bool
+}
+
+// Service is a pool operation observation service.
+type Service struct {
+	chainTime         chaintime.Service
+	submitter         submitter.Service
+	streams           streams.Service
+	nodeStatus        nodestatus.Service
+	observationWindow time.Duration
+
+	operationsMu sync.Mutex
+	operations   map[phase0.Root]*operation
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new pool operation observation service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "operations").Str("impl", "events").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:         parameters.chainTime,
+		submitter:         parameters.submitter,
+		streams:           parameters.streams,
+		nodeStatus:        parameters.nodeStatus,
+		observationWindow: parameters.observationWindow,
+		operations:        make(map[phase0.Root]*operation),
+	}
+
+	for address, eventsProvider := range parameters.eventsProviders {
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (s *Service) monitorEvents(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+) error {
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: []string{
+			typeAttesterSlashing,
+			typeProposerSlashing,
+			typeVoluntaryExit,
+			typeBLSToExecutionChange,
+		},
+		AttesterSlashingHandler: func(ctx context.Context, event *electra.AttesterSlashing) {
+			s.handleOperation(ctx, address, typeAttesterSlashing, event)
+		},
+		ProposerSlashingHandler: func(ctx context.Context, event *phase0.ProposerSlashing) {
+			s.handleOperation(ctx, address, typeProposerSlashing, event)
+		},
+		VoluntaryExitHandler: func(ctx context.Context, event *phase0.SignedVoluntaryExit) {
+			s.handleOperation(ctx, address, typeVoluntaryExit, event)
+		},
+		BLSToExecutionChangeHandler: func(ctx context.Context, event *capella.SignedBLSToExecutionChange) {
+			s.handleOperation(ctx, address, typeBLSToExecutionChange, event)
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create events provider")
+	}
+
+	return nil
+}
+
+func (s *Service) handleOperation(ctx context.Context,
+	address string,
+	operationType string,
+	event hashTreeRooter,
+) {
+	seen := time.Now()
+
+	// Ensure the node is in a state to provide useful information.
+	if !s.nodeStatus.Submittable(address) {
+		log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+		monitorEventIgnored()
+		return
+	}
+
+	hashTreeRoot, err := event.HashTreeRoot()
+	if err != nil {
+		log.Error().Str("type", operationType).Err(err).Msg("Failed to obtain operation root")
+		return
+	}
+	root := phase0.Root(hashTreeRoot)
+
+	s.operationsMu.Lock()
+	defer s.operationsMu.Unlock()
+
+	op, exists := s.operations[root]
+	first := !exists
+	if first {
+		op = &operation{
+			operationType: operationType,
+			firstSeen:     seen,
+			sightings:     make(map[string]time.Time),
+		}
+		s.operations[root] = op
+		time.AfterFunc(s.observationWindow, func() {
+			s.submitOperation(ctx, root)
+		})
+	}
+	if op.submitted {
+		log.Trace().Str("type", operationType).Stringer("root", root).Msg("Operation seen after observation window; ignoring")
+		return
+	}
+	if _, exists := op.sightings[address]; exists {
+		// Already seen by this node.
+		return
+	}
+	op.sightings[address] = seen
+
+	log.Trace().Str("address", address).Str("type", operationType).Stringer("root", root).Msg("Operation seen")
+	monitorEventProcessed(operationType, first, seen.Sub(op.firstSeen))
+}
+
+// submitOperation submits the summary of an operation at the end of its observation window.
+func (s *Service) submitOperation(ctx context.Context, root phase0.Root) {
+	s.operationsMu.Lock()
+	op, exists := s.operations[root]
+	if !exists {
+		s.operationsMu.Unlock()
+		return
+	}
+	op.submitted = true
+	data := &submitter.OperationSummary{
+		Method:    "operation events",
+		Type:      op.operationType,
+		Root:      root,
+		FirstSeen: op.firstSeen,
+		Sightings: make(map[string]*submitter.OperationSighting, len(op.sightings)),
+	}
+	for address, seen := range op.sightings {
+		data.Sightings[address] = &submitter.OperationSighting{
+			SinceFirst: seen.Sub(op.firstSeen),
+		}
+	}
+
+	// Submitted operations are retained for a while so that late sightings
+	// are not treated as new operations.
+	for retainedRoot, retained := range s.operations {
+		if retained.submitted && time.Since(retained.firstSeen) > 10*s.observationWindow {
+			delete(s.operations, retainedRoot)
+		}
+	}
+	s.operationsMu.Unlock()
+
+	log.Trace().Stringer("data", data).Msg("Operation summary")
+	s.submitter.SubmitOperationSummary(ctx, data)
+}
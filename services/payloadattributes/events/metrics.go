@@ -0,0 +1,129 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	delayTimer       prometheus.Histogram
+	latestTimestamp  prometheus.Gauge
+	eventsReceived   prometheus.Counter
+	eventsIgnored    prometheus.Counter
+	parentMismatches prometheus.Counter
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if latestTimestamp != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	delayTimer = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "payloadattributes",
+		Name:      "delay_seconds",
+		Help:      "The time from the start of the proposal slot to receipt of the payload attributes event.",
+		Buckets:   prometheus.LinearBuckets(-12, 0.5, 36),
+	})
+	if err := prometheus.Register(delayTimer); err != nil {
+		return err
+	}
+
+	latestTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "payloadattributes",
+		Name:      "latest_timestamp",
+		Help:      "The latest timestamp at which probec obtained a payload attributes event.",
+	})
+	if err := prometheus.Register(latestTimestamp); err != nil {
+		return err
+	}
+
+	eventsReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "payloadattributes",
+		Name:      "events_total",
+		Help:      "The number of payload attributes events received.",
+	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "payloadattributes",
+		Name:      "events_ignored_total",
+		Help:      "The number of payload attributes events ignored because their node was not in a submittable state.",
+	})
+	if err := prometheus.Register(eventsIgnored); err != nil {
+		return err
+	}
+
+	parentMismatches = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "payloadattributes",
+		Name:      "parent_mismatches_total",
+		Help:      "The number of proposal slots for which the payload attributes parent did not match the head.",
+	})
+
+	return prometheus.Register(parentMismatches)
+}
+
+// monitorEventProcessed is called when a payload attributes event has been processed.
+func monitorEventProcessed(delay time.Duration) {
+	if latestTimestamp == nil {
+		return
+	}
+
+	latestTimestamp.SetToCurrentTime()
+	eventsReceived.Inc()
+	delayTimer.Observe(delay.Seconds())
+}
+
+// monitorEventIgnored is called when a payload attributes event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
+
+// monitorParentMatchesHead is called when the parent of payload attributes has been checked against the head.
+func monitorParentMatchesHead(matches bool) {
+	if parentMismatches == nil {
+		return
+	}
+
+	if !matches {
+		parentMismatches.Inc()
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	mockchaintime "github.com/wealdtech/probec/services/chaintime/mock"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	"github.com/wealdtech/probec/services/submitter"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+// findDelay returns the delay for the given proposal slot and parent block root.
+func findDelay(delays []*submitter.PayloadAttributesDelay,
+	slot phase0.Slot,
+	parentBlockRoot phase0.Root,
+) *submitter.PayloadAttributesDelay {
+	for _, delay := range delays {
+		if delay.ProposalSlot == slot && delay.ParentBlockRoot == parentBlockRoot {
+			return delay
+		}
+	}
+
+	return nil
+}
+
+func TestHeadBefore(t *testing.T) {
+	heads := map[phase0.Slot]phase0.Root{
+		10: {0x0a},
+		11: {0x0b},
+		14: {0x0e},
+	}
+
+	tests := []struct {
+		name     string
+		slot     phase0.Slot
+		expected phase0.Root
+	}{
+		{
+			name: "NoEarlierHead",
+			slot: 10,
+		},
+		{
+			name:     "PreviousSlot",
+			slot:     12,
+			expected: phase0.Root{0x0b},
+		},
+		{
+			name:     "EmptySlots",
+			slot:     14,
+			expected: phase0.Root{0x0b},
+		},
+		{
+			name:     "Later",
+			slot:     20,
+			expected: phase0.Root{0x0e},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, headBefore(heads, test.slot))
+		})
+	}
+}
+
+func TestSubmitDelaysBefore(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime, err := mockchaintime.NewStandard(time.Now().Add(-time.Hour), 12*time.Second)
+	require.NoError(t, err)
+
+	recorder := mocksubmitter.NewRecorder()
+	s := &Service{
+		chainTime:  chainTime,
+		submitter:  recorder,
+		nodeStatus: mocknodestatus.New(),
+		nodes: map[string]*nodeState{
+			"a": {
+				attributes: make(map[phase0.Slot]map[phase0.Root]*payloadAttributes),
+				heads:      make(map[phase0.Slot]phase0.Root),
+			},
+		},
+	}
+
+	currentSlot := chainTime.CurrentSlot()
+	slot := currentSlot - 5
+	head := phase0.Root{0x01}
+	s.handleHead("a", &apiv1.HeadEvent{Slot: slot - 1, Block: head})
+
+	// Attributes built on another parent, followed by attributes built on the head.
+	s.handlePayloadAttributes("a", &apiv1.PayloadAttributesEvent{
+		Data: &apiv1.PayloadAttributesData{ProposalSlot: slot, ProposerIndex: 1, ParentBlockRoot: phase0.Root{0x02}},
+	})
+	time.Sleep(10 * time.Millisecond)
+	for range 2 {
+		s.handlePayloadAttributes("a", &apiv1.PayloadAttributesEvent{
+			Data: &apiv1.PayloadAttributesData{ProposalSlot: slot, ProposerIndex: 1, ParentBlockRoot: head},
+		})
+	}
+
+	// Attributes not built on the head, following an empty slot.
+	s.handlePayloadAttributes("a", &apiv1.PayloadAttributesEvent{
+		Data: &apiv1.PayloadAttributesData{ProposalSlot: slot + 1, ProposerIndex: 2, ParentBlockRoot: phase0.Root{0x03}},
+	})
+
+	// Attributes emitted ahead of the proposal slot, which is not yet submitted.
+	s.handlePayloadAttributes("a", &apiv1.PayloadAttributesEvent{
+		Data: &apiv1.PayloadAttributesData{ProposalSlot: currentSlot + 1, ProposerIndex: 3, ParentBlockRoot: head},
+	})
+
+	s.submitDelaysBefore(ctx, slot+2)
+	delays := recorder.PayloadAttributesDelays()
+	require.Len(t, delays, 3)
+
+	// Each parent is recorded with the timing of its own first event.
+	otherDelay := findDelay(delays, slot, phase0.Root{0x02})
+	require.NotNil(t, otherDelay)
+	require.Equal(t, uint64(1), otherDelay.Events)
+	require.False(t, otherDelay.ParentMatchesHead)
+	delay := findDelay(delays, slot, head)
+	require.NotNil(t, delay)
+	require.Equal(t, "mock", delay.Source)
+	require.Equal(t, phase0.ValidatorIndex(1), delay.ProposerIndex)
+	require.Equal(t, uint64(2), delay.Events)
+	require.True(t, delay.ParentMatchesHead)
+	require.GreaterOrEqual(t, delay.Delay, 5*chainTime.SlotDuration())
+	require.Greater(t, delay.Delay, otherDelay.Delay)
+
+	delay = findDelay(delays, slot+1, phase0.Root{0x03})
+	require.NotNil(t, delay)
+	require.Equal(t, phase0.ValidatorIndex(2), delay.ProposerIndex)
+	require.Equal(t, uint64(1), delay.Events)
+	require.False(t, delay.ParentMatchesHead)
+
+	// Attributes ahead of the slot have a negative delay.
+	require.Len(t, s.nodes["a"].attributes, 1)
+	require.Negative(t, s.nodes["a"].attributes[currentSlot+1][head].delay)
+}
@@ -0,0 +1,245 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// payloadAttributes contains the payload attributes seen by a node for a
+// proposal slot and parent block root.  The delay is that of the first event.
+type payloadAttributes struct {
+	proposerIndex   phase0.ValidatorIndex
+	parentBlockRoot phase0.Root
+	events          uint64
+	delay           time.Duration
+}
+
+// nodeState contains the payload attributes and heads seen by a single node.
+type nodeState struct {
+	// attributes are keyed by proposal slot and parent block root, as the
+	// parent changes if the node's head changes ahead of the proposal.
+	attributes map[phase0.Slot]map[phase0.Root]*payloadAttributes
+	heads      map[phase0.Slot]phase0.Root
+}
+
+// Service is a payload attributes timing service.
+type Service struct {
+	chainTime  chaintime.Service
+	submitter  submitter.Service
+	streams    streams.Service
+	nodeStatus nodestatus.Service
+
+	nodesMu sync.Mutex
+	nodes   map[string]*nodeState
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new payload attributes timing service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "payloadattributes").Str("impl", "events").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:  parameters.chainTime,
+		submitter:  parameters.submitter,
+		streams:    parameters.streams,
+		nodeStatus: parameters.nodeStatus,
+		nodes:      make(map[string]*nodeState),
+	}
+
+	for address, eventsProvider := range parameters.eventsProviders {
+		s.nodes[address] = &nodeState{
+			attributes: make(map[phase0.Slot]map[phase0.Root]*payloadAttributes),
+			heads:      make(map[phase0.Slot]phase0.Root),
+		}
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	go s.submitDelays(ctx)
+
+	return s, nil
+}
+
+func (s *Service) monitorEvents(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+) error {
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: []string{"head", "payload_attributes"},
+		HeadHandler: func(_ context.Context, event *apiv1.HeadEvent) {
+			s.handleHead(address, event)
+		},
+		PayloadAttributesHandler: func(_ context.Context, event *apiv1.PayloadAttributesEvent) {
+			s.handlePayloadAttributes(address, event)
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create events provider")
+	}
+
+	return nil
+}
+
+func (s *Service) handleHead(address string, event *apiv1.HeadEvent) {
+	s.nodesMu.Lock()
+	defer s.nodesMu.Unlock()
+
+	s.nodes[address].heads[event.Slot] = event.Block
+}
+
+func (s *Service) handlePayloadAttributes(address string, event *apiv1.PayloadAttributesEvent) {
+	if event.Data == nil {
+		log.Debug().Msg("Payload attributes event without data; ignoring")
+		return
+	}
+	// Delay is relative to the start of the proposal slot, so is negative
+	// if the attributes are emitted ahead of the slot.
+	delay := time.Since(s.chainTime.StartOfSlot(event.Data.ProposalSlot))
+
+	// Ensure the node is in a state to provide useful information.
+	if !s.nodeStatus.Submittable(address) {
+		log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+		monitorEventIgnored()
+		return
+	}
+
+	s.nodesMu.Lock()
+	node := s.nodes[address]
+	slotAttributes, exists := node.attributes[event.Data.ProposalSlot]
+	if !exists {
+		slotAttributes = make(map[phase0.Root]*payloadAttributes)
+		node.attributes[event.Data.ProposalSlot] = slotAttributes
+	}
+	attributes, exists := slotAttributes[event.Data.ParentBlockRoot]
+	if !exists {
+		attributes = &payloadAttributes{
+			proposerIndex:   event.Data.ProposerIndex,
+			parentBlockRoot: event.Data.ParentBlockRoot,
+			delay:           delay,
+		}
+		slotAttributes[event.Data.ParentBlockRoot] = attributes
+	}
+	attributes.events++
+	s.nodesMu.Unlock()
+
+	log.Trace().
+		Str("address", address).
+		Uint64("proposal_slot", uint64(event.Data.ProposalSlot)).
+		Stringer("parent_block_root", event.Data.ParentBlockRoot).
+		Stringer("delay", delay).
+		Msg("Received payload attributes event")
+	monitorEventProcessed(delay)
+}
+
+// submitDelays submits the delays for each proposal slot once the head
+// for the slot is known.
+func (s *Service) submitDelays(ctx context.Context) {
+	for {
+		timer := time.NewTimer(time.Until(s.chainTime.StartOfSlot(s.chainTime.CurrentSlot() + 1)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.submitDelaysBefore(ctx, s.chainTime.CurrentSlot())
+	}
+}
+
+// submitDelaysBefore submits and removes the delays for proposal slots before the given slot.
+func (s *Service) submitDelaysBefore(ctx context.Context, slot phase0.Slot) {
+	delays := make([]*submitter.PayloadAttributesDelay, 0)
+
+	s.nodesMu.Lock()
+	for address, node := range s.nodes {
+		for proposalSlot, slotAttributes := range node.attributes {
+			if proposalSlot >= slot {
+				continue
+			}
+			head := headBefore(node.heads, proposalSlot)
+			for _, attributes := range slotAttributes {
+				parentMatchesHead := attributes.parentBlockRoot == head
+				monitorParentMatchesHead(parentMatchesHead)
+				delays = append(delays, &submitter.PayloadAttributesDelay{
+					Source:            s.nodeStatus.Status(address).Version,
+					Method:            "payload attributes event",
+					ProposalSlot:      proposalSlot,
+					ProposerIndex:     attributes.proposerIndex,
+					ParentBlockRoot:   attributes.parentBlockRoot,
+					Events:            attributes.events,
+					Delay:             attributes.delay,
+					ParentMatchesHead: parentMatchesHead,
+				})
+			}
+			delete(node.attributes, proposalSlot)
+		}
+		// Retain a few heads, as proposal slots may be preceded by empty slots.
+		for headSlot := range node.heads {
+			if headSlot+phase0.Slot(s.chainTime.SlotsPerEpoch()) < slot {
+				delete(node.heads, headSlot)
+			}
+		}
+	}
+	s.nodesMu.Unlock()
+
+	for _, delay := range delays {
+		log.Trace().Stringer("data", delay).Msg("Payload attributes delay")
+		s.submitter.SubmitPayloadAttributesDelay(ctx, delay)
+	}
+}
+
+// headBefore returns the most recent head prior to the given slot.
+func headBefore(heads map[phase0.Slot]phase0.Root, slot phase0.Slot) phase0.Root {
+	var headSlot phase0.Slot
+	var head phase0.Root
+	for candidateSlot, candidate := range heads {
+		if candidateSlot < slot && candidateSlot >= headSlot {
+			headSlot = candidateSlot
+			head = candidate
+		}
+	}
+
+	return head
+}
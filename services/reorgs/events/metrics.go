@@ -0,0 +1,120 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	delayTimer      prometheus.Histogram
+	depthHistogram  prometheus.Histogram
+	latestTimestamp prometheus.Gauge
+	eventsReceived  prometheus.Counter
+	eventsIgnored   prometheus.Counter
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if latestTimestamp != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	delayTimer = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "reorgs",
+		Name:      "delay_seconds",
+		Help:      "The time from the start of the slot to receipt of the chain reorg event.",
+		Buckets:   prometheus.LinearBuckets(0.5, 0.5, 24),
+	})
+	if err := prometheus.Register(delayTimer); err != nil {
+		return err
+	}
+
+	depthHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "reorgs",
+		Name:      "depth",
+		Help:      "The depth of chain reorgs.",
+		Buckets:   []float64{1, 2, 3, 4, 5, 6, 8, 16, 32, 64},
+	})
+	if err := prometheus.Register(depthHistogram); err != nil {
+		return err
+	}
+
+	latestTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "reorgs",
+		Name:      "latest_timestamp",
+		Help:      "The latest timestamp at which probec obtained a chain reorg event.",
+	})
+	if err := prometheus.Register(latestTimestamp); err != nil {
+		return err
+	}
+
+	eventsReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "reorgs",
+		Name:      "events_total",
+		Help:      "The number of chain reorg events received.",
+	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "reorgs",
+		Name:      "events_ignored_total",
+		Help:      "The number of chain reorg events ignored because their node was not in a submittable state.",
+	})
+
+	return prometheus.Register(eventsIgnored)
+}
+
+// monitorEventProcessed is called when a chain reorg event has been processed.
+func monitorEventProcessed(depth uint64, delay time.Duration) {
+	if latestTimestamp == nil {
+		return
+	}
+
+	latestTimestamp.SetToCurrentTime()
+	eventsReceived.Inc()
+	depthHistogram.Observe(float64(depth))
+	delayTimer.Observe(delay.Seconds())
+}
+
+// monitorEventIgnored is called when a chain reorg event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
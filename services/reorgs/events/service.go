@@ -0,0 +1,127 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// Service is a chain reorganisation monitoring service.
+type Service struct {
+	chainTime  chaintime.Service
+	submitter  submitter.Service
+	streams    streams.Service
+	nodeStatus nodestatus.Service
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new chain reorganisation monitoring service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "reorgs").Str("impl", "events").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:  parameters.chainTime,
+		submitter:  parameters.submitter,
+		streams:    parameters.streams,
+		nodeStatus: parameters.nodeStatus,
+	}
+
+	for address, eventsProvider := range parameters.eventsProviders {
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (s *Service) monitorEvents(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+) error {
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: []string{"chain_reorg"},
+		ChainReorgHandler: func(ctx context.Context, event *apiv1.ChainReorgEvent) {
+			s.handleChainReorg(ctx, address, event)
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create events provider")
+	}
+
+	return nil
+}
+
+func (s *Service) handleChainReorg(ctx context.Context,
+	address string,
+	event *apiv1.ChainReorgEvent,
+) {
+	delay := time.Since(s.chainTime.StartOfSlot(event.Slot))
+	log.Trace().
+		Str("address", address).
+		Uint64("slot", uint64(event.Slot)).
+		Uint64("depth", event.Depth).
+		Stringer("old_head_block", event.OldHeadBlock).
+		Stringer("new_head_block", event.NewHeadBlock).
+		Stringer("delay", delay).
+		Msg("Received chain reorg event")
+
+	// Ensure the node is in a state to provide useful information.
+	if !s.nodeStatus.Submittable(address) {
+		log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+		monitorEventIgnored()
+		return
+	}
+
+	monitorEventProcessed(event.Depth, delay)
+
+	s.submitter.SubmitReorg(ctx, &submitter.Reorg{
+		Source:       s.nodeStatus.Status(address).Version,
+		Method:       "chain reorg event",
+		Slot:         event.Slot,
+		Depth:        event.Depth,
+		OldHeadBlock: event.OldHeadBlock,
+		NewHeadBlock: event.NewHeadBlock,
+		OldHeadState: event.OldHeadState,
+		NewHeadState: event.NewHeadState,
+		Epoch:        event.Epoch,
+		Delay:        delay,
+	})
+}
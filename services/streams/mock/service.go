@@ -0,0 +1,39 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/wealdtech/probec/services/streams"
+)
+
+// service is a mock streams service that subscribes directly.
+type service struct{}
+
+// New creates a new mock streams service.
+func New() streams.Service {
+	return &service{}
+}
+
+// Subscribe subscribes to events from the given address.
+func (*service) Subscribe(ctx context.Context,
+	_ string,
+	eventsProvider consensusclient.EventsProvider,
+	opts *api.EventsOpts,
+) error {
+	return eventsProvider.Events(ctx, opts)
+}
@@ -0,0 +1,133 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// wrapHandlers returns a copy of the options with each handler wrapped
+// so that the supplied function is called whenever an event is received.
+//
+//nolint:gocyclo
+func wrapHandlers(opts *api.EventsOpts, received func()) *api.EventsOpts {
+	wrapped := *opts
+
+	if opts.Handler != nil {
+		wrapped.Handler = func(event *apiv1.Event) {
+			received()
+			opts.Handler(event)
+		}
+	}
+	if opts.AttestationHandler != nil {
+		wrapped.AttestationHandler = func(ctx context.Context, event *spec.VersionedAttestation) {
+			received()
+			opts.AttestationHandler(ctx, event)
+		}
+	}
+	if opts.AttesterSlashingHandler != nil {
+		wrapped.AttesterSlashingHandler = func(ctx context.Context, event *electra.AttesterSlashing) {
+			received()
+			opts.AttesterSlashingHandler(ctx, event)
+		}
+	}
+	if opts.BlobSidecarHandler != nil {
+		wrapped.BlobSidecarHandler = func(ctx context.Context, event *apiv1.BlobSidecarEvent) {
+			received()
+			opts.BlobSidecarHandler(ctx, event)
+		}
+	}
+	if opts.BlockHandler != nil {
+		wrapped.BlockHandler = func(ctx context.Context, event *apiv1.BlockEvent) {
+			received()
+			opts.BlockHandler(ctx, event)
+		}
+	}
+	if opts.BlockGossipHandler != nil {
+		wrapped.BlockGossipHandler = func(ctx context.Context, event *apiv1.BlockGossipEvent) {
+			received()
+			opts.BlockGossipHandler(ctx, event)
+		}
+	}
+	if opts.BLSToExecutionChangeHandler != nil {
+		wrapped.BLSToExecutionChangeHandler = func(ctx context.Context, event *capella.SignedBLSToExecutionChange) {
+			received()
+			opts.BLSToExecutionChangeHandler(ctx, event)
+		}
+	}
+	if opts.ChainReorgHandler != nil {
+		wrapped.ChainReorgHandler = func(ctx context.Context, event *apiv1.ChainReorgEvent) {
+			received()
+			opts.ChainReorgHandler(ctx, event)
+		}
+	}
+	if opts.ContributionAndProofHandler != nil {
+		wrapped.ContributionAndProofHandler = func(ctx context.Context, event *altair.SignedContributionAndProof) {
+			received()
+			opts.ContributionAndProofHandler(ctx, event)
+		}
+	}
+	if opts.DataColumnSidecarHandler != nil {
+		wrapped.DataColumnSidecarHandler = func(ctx context.Context, event *apiv1.DataColumnSidecarEvent) {
+			received()
+			opts.DataColumnSidecarHandler(ctx, event)
+		}
+	}
+	if opts.FinalizedCheckpointHandler != nil {
+		wrapped.FinalizedCheckpointHandler = func(ctx context.Context, event *apiv1.FinalizedCheckpointEvent) {
+			received()
+			opts.FinalizedCheckpointHandler(ctx, event)
+		}
+	}
+	if opts.HeadHandler != nil {
+		wrapped.HeadHandler = func(ctx context.Context, event *apiv1.HeadEvent) {
+			received()
+			opts.HeadHandler(ctx, event)
+		}
+	}
+	if opts.PayloadAttributesHandler != nil {
+		wrapped.PayloadAttributesHandler = func(ctx context.Context, event *apiv1.PayloadAttributesEvent) {
+			received()
+			opts.PayloadAttributesHandler(ctx, event)
+		}
+	}
+	if opts.ProposerSlashingHandler != nil {
+		wrapped.ProposerSlashingHandler = func(ctx context.Context, event *phase0.ProposerSlashing) {
+			received()
+			opts.ProposerSlashingHandler(ctx, event)
+		}
+	}
+	if opts.SingleAttestationHandler != nil {
+		wrapped.SingleAttestationHandler = func(ctx context.Context, event *electra.SingleAttestation) {
+			received()
+			opts.SingleAttestationHandler(ctx, event)
+		}
+	}
+	if opts.VoluntaryExitHandler != nil {
+		wrapped.VoluntaryExitHandler = func(ctx context.Context, event *phase0.SignedVoluntaryExit) {
+			received()
+			opts.VoluntaryExitHandler(ctx, event)
+		}
+	}
+
+	return &wrapped
+}
@@ -0,0 +1,101 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	streamState        *prometheus.GaugeVec
+	streamReconnects   *prometheus.CounterVec
+	streamLatestEvents *prometheus.GaugeVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if streamState != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	streamState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "streams",
+		Name:      "state",
+		Help:      "The state of the event stream (0 = disconnected, 1 = connected, 2 = stalled).",
+	}, []string{"source", "topics"})
+	if err := prometheus.Register(streamState); err != nil {
+		return err
+	}
+
+	streamReconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "streams",
+		Name:      "reconnects_total",
+		Help:      "The number of times the event stream has been reconnected.",
+	}, []string{"source", "topics"})
+	if err := prometheus.Register(streamReconnects); err != nil {
+		return err
+	}
+
+	streamLatestEvents = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "streams",
+		Name:      "latest_event_timestamp",
+		Help:      "The latest timestamp at which probec obtained an event from the stream.",
+	}, []string{"source", "topics"})
+
+	return prometheus.Register(streamLatestEvents)
+}
+
+// monitorStreamState is called when the state of a stream changes.
+func monitorStreamState(source string, topics string, state streamStatus) {
+	if streamState == nil {
+		return
+	}
+
+	streamState.WithLabelValues(source, topics).Set(float64(state))
+}
+
+// monitorStreamReconnect is called when a stream is reconnected.
+func monitorStreamReconnect(source string, topics string) {
+	if streamReconnects == nil {
+		return
+	}
+
+	streamReconnects.WithLabelValues(source, topics).Inc()
+}
+
+// monitorStreamEvent is called when an event is received on a stream.
+func monitorStreamEvent(source string, topics string) {
+	if streamLatestEvents == nil {
+		return
+	}
+
+	streamLatestEvents.WithLabelValues(source, topics).SetToCurrentTime()
+}
@@ -0,0 +1,118 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/metrics"
+	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
+)
+
+type parameters struct {
+	logLevel       zerolog.Level
+	monitor        metrics.Service
+	chainTime      chaintime.Service
+	stallSlots     uint64
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the module.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithChainTime sets the chain time service for this module.
+func WithChainTime(service chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = service
+	})
+}
+
+// WithStallSlots sets the number of slots without events after which a stream is considered stalled.
+// A value of 0 disables stall detection.
+func WithStallSlots(slots uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.stallSlots = slots
+	})
+}
+
+// WithInitialBackoff sets the initial delay before reconnecting a failed stream.
+func WithInitialBackoff(backoff time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.initialBackoff = backoff
+	})
+}
+
+// WithMaxBackoff sets the maximum delay before reconnecting a failed stream.
+func WithMaxBackoff(backoff time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxBackoff = backoff
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:       zerolog.GlobalLevel(),
+		monitor:        nullmetrics.New(),
+		stallSlots:     5,
+		initialBackoff: time.Second,
+		maxBackoff:     time.Minute,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.monitor == nil {
+		return nil, errors.New("monitor not supplied")
+	}
+	if parameters.chainTime == nil {
+		return nil, errors.New("chain time service not supplied")
+	}
+	if parameters.initialBackoff <= 0 {
+		return nil, errors.New("initial backoff must be greater than 0")
+	}
+	if parameters.maxBackoff < parameters.initialBackoff {
+		return nil, errors.New("max backoff cannot be less than initial backoff")
+	}
+
+	return &parameters, nil
+}
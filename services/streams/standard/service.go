@@ -0,0 +1,217 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+)
+
+// streamStatus is the status of a stream.
+type streamStatus int
+
+const (
+	streamStatusDisconnected streamStatus = iota
+	streamStatusConnected
+	streamStatusStalled
+)
+
+// regularTopics are topics that are expected to provide events every slot,
+// and as such can be checked for stalls.
+var regularTopics = map[string]bool{
+	"attestation":            true,
+	"block":                  true,
+	"contribution_and_proof": true,
+	"head":                   true,
+	"single_attestation":     true,
+}
+
+// Service is a service that supervises event streams.
+type Service struct {
+	chainTime      chaintime.Service
+	stallSlots     uint64
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// stream holds the state of a single supervised stream.
+type stream struct {
+	address   string
+	topics    string
+	regular   bool
+	lastEvent atomic.Int64
+	events    atomic.Uint64
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new streams service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "streams").Str("impl", "standard").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:      parameters.chainTime,
+		stallSlots:     parameters.stallSlots,
+		initialBackoff: parameters.initialBackoff,
+		maxBackoff:     parameters.maxBackoff,
+	}
+
+	return s, nil
+}
+
+// Subscribe subscribes to events from the given address, keeping the
+// subscription alive until the context is cancelled.
+func (s *Service) Subscribe(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+	opts *api.EventsOpts,
+) error {
+	if eventsProvider == nil {
+		return errors.New("no events provider supplied")
+	}
+	if opts == nil || len(opts.Topics) == 0 {
+		return errors.New("no topics supplied")
+	}
+
+	st := &stream{
+		address: address,
+		topics:  strings.Join(opts.Topics, ","),
+	}
+	for _, topic := range opts.Topics {
+		if regularTopics[topic] {
+			st.regular = true
+		}
+	}
+	monitorStreamState(st.address, st.topics, streamStatusDisconnected)
+
+	go s.supervise(ctx, st, eventsProvider, wrapHandlers(opts, st.eventReceived))
+
+	return nil
+}
+
+// supervise keeps a stream connected until the context is cancelled.
+func (s *Service) supervise(ctx context.Context,
+	st *stream,
+	eventsProvider consensusclient.EventsProvider,
+	opts *api.EventsOpts,
+) {
+	log := log.With().Str("source", st.address).Str("topics", st.topics).Logger()
+
+	backoff := s.initialBackoff
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			monitorStreamReconnect(st.address, st.topics)
+		}
+
+		subCtx, cancel := context.WithCancel(ctx)
+		if err := eventsProvider.Events(subCtx, opts); err != nil {
+			log.Warn().Err(err).Stringer("backoff", backoff).Msg("Failed to subscribe to event stream")
+			st.setStatus(streamStatusDisconnected)
+		} else {
+			if attempt > 0 {
+				log.Info().Msg("Resubscribed to event stream")
+			} else {
+				log.Debug().Msg("Subscribed to event stream")
+			}
+			st.setStatus(streamStatusConnected)
+			eventsAtConnect := st.events.Load()
+			st.lastEvent.Store(time.Now().UnixNano())
+			s.watch(ctx, st)
+			if ctx.Err() != nil {
+				cancel()
+				st.setStatus(streamStatusDisconnected)
+
+				return
+			}
+			if st.events.Load() != eventsAtConnect {
+				// Stream was working for a while, so start the backoff again.
+				backoff = s.initialBackoff
+			}
+			log.Warn().Stringer("backoff", backoff).Msg("Event stream stalled; reconnecting")
+			st.setStatus(streamStatusStalled)
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			st.setStatus(streamStatusDisconnected)
+
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+// watch returns when the stream is stalled or the context is cancelled.
+func (s *Service) watch(ctx context.Context, st *stream) {
+	if s.stallSlots == 0 || !st.regular {
+		// Stall detection is not in operation for this stream.
+		<-ctx.Done()
+
+		return
+	}
+
+	stallDuration := time.Duration(s.stallSlots) * s.chainTime.SlotDuration()
+	ticker := time.NewTicker(s.chainTime.SlotDuration() / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if time.Since(time.Unix(0, st.lastEvent.Load())) > stallDuration {
+				return
+			}
+		}
+	}
+}
+
+// eventReceived is called whenever an event is received on the stream.
+func (st *stream) eventReceived() {
+	st.lastEvent.Store(time.Now().UnixNano())
+	st.events.Add(1)
+	monitorStreamEvent(st.address, st.topics)
+}
+
+// setStatus sets the status of the stream.
+func (st *stream) setStatus(status streamStatus) {
+	monitorStreamState(st.address, st.topics, status)
+}
@@ -0,0 +1,261 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/chaintime"
+	standardchaintime "github.com/wealdtech/probec/services/chaintime/standard"
+	"github.com/wealdtech/probec/services/streams/standard"
+)
+
+// createMockClient creates a mock client.  The mock client logs through a
+// package-level logger when its context is done, so it is created once per
+// test with a context that is not cancelled to avoid racing with later tests.
+func createMockClient(t *testing.T) *mock.Service {
+	t.Helper()
+
+	mockClient, err := mock.New(context.Background())
+	require.NoError(t, err)
+
+	return mockClient
+}
+
+// createChainTime creates a chain time service with short slots.
+func createChainTime(ctx context.Context, t *testing.T, mockClient *mock.Service, slotDuration time.Duration) chaintime.Service {
+	t.Helper()
+
+	specResponse, err := mockClient.Spec(ctx, &api.SpecOpts{})
+	require.NoError(t, err)
+	spec := specResponse.Data
+	spec["SECONDS_PER_SLOT"] = slotDuration
+	mockClient.SpecFunc = func(context.Context, *api.SpecOpts) (*api.Response[map[string]any], error) {
+		return &api.Response[map[string]any]{
+			Data:     spec,
+			Metadata: make(map[string]any),
+		}, nil
+	}
+
+	chainTime, err := standardchaintime.New(ctx,
+		standardchaintime.WithLogLevel(zerolog.Disabled),
+		standardchaintime.WithGenesisProvider(mockClient),
+		standardchaintime.WithSpecProvider(mockClient),
+		standardchaintime.WithForkScheduleProvider(mockClient),
+	)
+	require.NoError(t, err)
+
+	return chainTime
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	chainTime := createChainTime(ctx, t, createMockClient(t), 12*time.Second)
+
+	tests := []struct {
+		name   string
+		params []standard.Parameter
+		err    string
+	}{
+		{
+			name: "MonitorMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithMonitor(nil),
+				standard.WithChainTime(chainTime),
+			},
+			err: "problem with parameters: monitor not supplied",
+		},
+		{
+			name: "ChainTimeMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: chain time service not supplied",
+		},
+		{
+			name: "InitialBackoffZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithChainTime(chainTime),
+				standard.WithInitialBackoff(0),
+			},
+			err: "problem with parameters: initial backoff must be greater than 0",
+		},
+		{
+			name: "MaxBackoffLow",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithChainTime(chainTime),
+				standard.WithInitialBackoff(time.Minute),
+				standard.WithMaxBackoff(time.Second),
+			},
+			err: "problem with parameters: max backoff cannot be less than initial backoff",
+		},
+		{
+			name: "Good",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithChainTime(chainTime),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := standard.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// disconnectingProvider is an events provider that fails its first
+// subscription, then sends a few events on each subsequent subscription
+// before falling silent.
+type disconnectingProvider struct {
+	mu            sync.Mutex
+	subscriptions int
+	cancelled     int
+}
+
+func (p *disconnectingProvider) Events(ctx context.Context, opts *api.EventsOpts) error {
+	p.mu.Lock()
+	p.subscriptions++
+	subscription := p.subscriptions
+	p.mu.Unlock()
+
+	if subscription == 1 {
+		return errors.New("connection refused")
+	}
+
+	go func() {
+		for i := range 2 {
+			opts.BlockHandler(ctx, &apiv1.BlockEvent{Slot: 1})
+			time.Sleep(time.Duration(i) * time.Millisecond)
+		}
+		<-ctx.Done()
+		p.mu.Lock()
+		p.cancelled++
+		p.mu.Unlock()
+	}()
+
+	return nil
+}
+
+func (p *disconnectingProvider) counts() (int, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.subscriptions, p.cancelled
+}
+
+func TestReconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithChainTime(createChainTime(ctx, t, createMockClient(t), 20*time.Millisecond)),
+		standard.WithStallSlots(2),
+		standard.WithInitialBackoff(10*time.Millisecond),
+		standard.WithMaxBackoff(20*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	provider := &disconnectingProvider{}
+	var eventsMu sync.Mutex
+	events := 0
+	require.NoError(t, s.Subscribe(ctx, "test", provider, &api.EventsOpts{
+		Topics: []string{"block"},
+		BlockHandler: func(_ context.Context, _ *apiv1.BlockEvent) {
+			eventsMu.Lock()
+			events++
+			eventsMu.Unlock()
+		},
+	}))
+
+	// Failed subscription, then at least two stalled subscriptions.
+	require.Eventually(t, func() bool {
+		_, cancelled := provider.counts()
+
+		return cancelled >= 2
+	}, 5*time.Second, 10*time.Millisecond)
+	subscriptions, _ := provider.counts()
+	require.GreaterOrEqual(t, subscriptions, 3)
+	eventsMu.Lock()
+	require.GreaterOrEqual(t, events, 4)
+	eventsMu.Unlock()
+}
+
+func TestNoStallDetectionForIrregularTopics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	provider := createMockClient(t)
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithChainTime(createChainTime(ctx, t, provider, 20*time.Millisecond)),
+		standard.WithStallSlots(1),
+		standard.WithInitialBackoff(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	subscriptions := 0
+	provider.EventsFunc = func(context.Context, *api.EventsOpts) error {
+		mu.Lock()
+		subscriptions++
+		mu.Unlock()
+
+		return nil
+	}
+	require.NoError(t, s.Subscribe(ctx, "test", provider, &api.EventsOpts{
+		Topics:            []string{"chain_reorg"},
+		ChainReorgHandler: func(context.Context, *apiv1.ChainReorgEvent) {},
+	}))
+
+	time.Sleep(200 * time.Millisecond)
+	mu.Lock()
+	require.Equal(t, 1, subscriptions)
+	mu.Unlock()
+}
+
+func TestSubscribeBadInput(t *testing.T) {
+	ctx := context.Background()
+
+	provider := createMockClient(t)
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithChainTime(createChainTime(ctx, t, provider, 12*time.Second)),
+	)
+	require.NoError(t, err)
+
+	require.EqualError(t, s.Subscribe(ctx, "test", nil, &api.EventsOpts{Topics: []string{"block"}}), "no events provider supplied")
+	require.EqualError(t, s.Subscribe(ctx, "test", provider, nil), "no topics supplied")
+	require.EqualError(t, s.Subscribe(ctx, "test", provider, &api.EventsOpts{}), "no topics supplied")
+}
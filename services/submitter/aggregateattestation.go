@@ -0,0 +1,153 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// AggregateAttestation is an aggregate attestation data point.
+type AggregateAttestation struct {
+	Source         string
+	Method         string
+	Slot           phase0.Slot
+	CommitteeIndex phase0.CommitteeIndex
+	// CommitteeIndices are the committees covered by the attestation, in the
+	// order in which their aggregation bits appear.  They are only present if
+	// the attestation spans multiple committees, which is possible from Electra.
+	CommitteeIndices []phase0.CommitteeIndex
+	BeaconBlockRoot  phase0.Root
+	SourceRoot       phase0.Root
+	TargetRoot       phase0.Root
+	AggregationBits  bitfield.Bitlist
+	Delay            time.Duration
+}
+
+// aggregateAttestationJSON is the wire representation of the struct.
+type aggregateAttestationJSON struct {
+	Source           string      `json:"source"`
+	Method           string      `json:"method"`
+	Slot             string      `json:"slot"`
+	CommitteeIndex   string      `json:"committee_index"`
+	CommitteeIndices []string    `json:"committee_indices,omitempty"`
+	BeaconBlockRoot  phase0.Root `json:"beacon_block_root"`
+	SourceRoot       phase0.Root `json:"source_root"`
+	TargetRoot       phase0.Root `json:"target_root"`
+	AggregationBits  string      `json:"aggregation_bits"`
+	DelayMS          string      `json:"delay_ms"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AggregateAttestation) MarshalJSON() ([]byte, error) {
+	var committeeIndices []string
+	if len(a.CommitteeIndices) > 0 {
+		committeeIndices = make([]string, len(a.CommitteeIndices))
+		for i := range a.CommitteeIndices {
+			committeeIndices[i] = fmt.Sprintf("%d", a.CommitteeIndices[i])
+		}
+	}
+
+	return json.Marshal(&aggregateAttestationJSON{
+		Source:           a.Source,
+		Method:           a.Method,
+		Slot:             fmt.Sprintf("%d", a.Slot),
+		CommitteeIndex:   fmt.Sprintf("%d", a.CommitteeIndex),
+		CommitteeIndices: committeeIndices,
+		BeaconBlockRoot:  a.BeaconBlockRoot,
+		SourceRoot:       a.SourceRoot,
+		TargetRoot:       a.TargetRoot,
+		AggregationBits:  fmt.Sprintf("%#x", []byte(a.AggregationBits)),
+		DelayMS:          fmt.Sprintf("%d", a.Delay.Milliseconds()),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AggregateAttestation) UnmarshalJSON(input []byte) error {
+	var data aggregateAttestationJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Source == "" {
+		return errors.New("source missing")
+	}
+	a.Source = data.Source
+	a.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	a.Slot = phase0.Slot(slot)
+	if data.CommitteeIndex == "" {
+		return errors.New("committee index missing")
+	}
+	committeeIndex, err := strconv.ParseUint(data.CommitteeIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for committee index")
+	}
+	a.CommitteeIndex = phase0.CommitteeIndex(committeeIndex)
+	if len(data.CommitteeIndices) > 0 {
+		a.CommitteeIndices = make([]phase0.CommitteeIndex, len(data.CommitteeIndices))
+		for i := range data.CommitteeIndices {
+			committeeIndex, err := strconv.ParseUint(data.CommitteeIndices[i], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid value for committee indices")
+			}
+			a.CommitteeIndices[i] = phase0.CommitteeIndex(committeeIndex)
+		}
+	}
+	a.BeaconBlockRoot = data.BeaconBlockRoot
+	a.SourceRoot = data.SourceRoot
+	a.TargetRoot = data.TargetRoot
+	if data.AggregationBits == "" {
+		return errors.New("aggregation bits missing")
+	}
+	aggregationBits, err := hex.DecodeString(strings.TrimPrefix(data.AggregationBits, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for aggregation bits")
+	}
+	a.AggregationBits = aggregationBits
+	if data.DelayMS == "" {
+		return errors.New("delay missing")
+	}
+	delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for delay")
+	}
+	a.Delay = time.Duration(delay) * time.Millisecond
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (a *AggregateAttestation) String() string {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
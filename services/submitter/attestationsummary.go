@@ -0,0 +1,294 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// AttestationSummarySchemaVersion is the current version of the attestation summary schema.
+const AttestationSummarySchemaVersion = 3
+
+// AttestationSummary is a summary of the attestations seen for a slot.
+type AttestationSummary struct {
+	SchemaVersion uint64
+	Method        string
+	Slot          phase0.Slot
+	// BucketWidth is the period of the slot covered by each bucket.
+	BucketWidth  time.Duration
+	Attestations []*AttestationVoteSummary
+	// Correctness is keyed by source, and counts the attesters seen by that
+	// source according to how their votes compare with its view of the chain.
+	Correctness map[string]*AttestationVoteCorrectness
+}
+
+// attestationSummaryJSON is the wire representation of the struct.
+type attestationSummaryJSON struct {
+	SchemaVersion string                                 `json:"schema_version"`
+	Method        string                                 `json:"method"`
+	Slot          string                                 `json:"slot"`
+	BucketWidthMS string                                 `json:"bucket_width_ms"`
+	Attestations  []*AttestationVoteSummary              `json:"attestations"`
+	Correctness   map[string]*AttestationVoteCorrectness `json:"correctness,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AttestationSummary) MarshalJSON() ([]byte, error) {
+	attestations := a.Attestations
+	if attestations == nil {
+		attestations = make([]*AttestationVoteSummary, 0)
+	}
+
+	return json.Marshal(&attestationSummaryJSON{
+		SchemaVersion: strconv.FormatUint(a.SchemaVersion, 10),
+		Method:        a.Method,
+		Slot:          fmt.Sprintf("%d", a.Slot),
+		BucketWidthMS: fmt.Sprintf("%d", a.BucketWidth.Milliseconds()),
+		Attestations:  attestations,
+		Correctness:   a.Correctness,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AttestationSummary) UnmarshalJSON(input []byte) error {
+	var data attestationSummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.SchemaVersion == "" {
+		return errors.New("schema version missing")
+	}
+	schemaVersion, err := strconv.ParseUint(data.SchemaVersion, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for schema version")
+	}
+	a.SchemaVersion = schemaVersion
+	a.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	a.Slot = phase0.Slot(slot)
+	if data.BucketWidthMS == "" {
+		return errors.New("bucket width missing")
+	}
+	bucketWidth, err := strconv.ParseInt(data.BucketWidthMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for bucket width")
+	}
+	a.BucketWidth = time.Duration(bucketWidth) * time.Millisecond
+	if data.Attestations == nil {
+		return errors.New("attestations missing")
+	}
+	a.Attestations = data.Attestations
+	a.Correctness = data.Correctness
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (a *AttestationSummary) String() string {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// AttestationVoteSummary is a summary of the attestations seen for a single vote.
+// Buckets are keyed by source, and each bucket holds the aggregation bits seen
+// within that period of the slot.
+// Attesters are keyed by source, and map the index of each attester seen in a
+// single attestation, or resolved from the aggregation bits in the buckets, to
+// the bucket in which it was first seen.
+type AttestationVoteSummary struct {
+	CommitteeIndex  phase0.CommitteeIndex
+	BeaconBlockRoot phase0.Root
+	SourceRoot      phase0.Root
+	TargetRoot      phase0.Root
+	Buckets         map[string][]bitfield.Bitlist
+	Attesters       map[string]map[phase0.ValidatorIndex]uint64
+}
+
+// attestationVoteSummaryJSON is the wire representation of the struct.
+type attestationVoteSummaryJSON struct {
+	CommitteeIndex  string                       `json:"committee_index"`
+	BeaconBlockRoot phase0.Root                  `json:"beacon_block_root"`
+	SourceRoot      phase0.Root                  `json:"source_root"`
+	TargetRoot      phase0.Root                  `json:"target_root"`
+	Buckets         map[string][]string          `json:"buckets"`
+	Attesters       map[string]map[string]string `json:"attesters,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AttestationVoteSummary) MarshalJSON() ([]byte, error) {
+	buckets := make(map[string][]string, len(a.Buckets))
+	for source, sourceBuckets := range a.Buckets {
+		buckets[source] = make([]string, len(sourceBuckets))
+		for i := range sourceBuckets {
+			buckets[source][i] = fmt.Sprintf("%#x", []byte(sourceBuckets[i]))
+		}
+	}
+
+	var attesters map[string]map[string]string
+	if len(a.Attesters) > 0 {
+		attesters = make(map[string]map[string]string, len(a.Attesters))
+		for source, sourceAttesters := range a.Attesters {
+			attesters[source] = make(map[string]string, len(sourceAttesters))
+			for attesterIndex, bucket := range sourceAttesters {
+				attesters[source][fmt.Sprintf("%d", attesterIndex)] = strconv.FormatUint(bucket, 10)
+			}
+		}
+	}
+
+	return json.Marshal(&attestationVoteSummaryJSON{
+		CommitteeIndex:  fmt.Sprintf("%d", a.CommitteeIndex),
+		BeaconBlockRoot: a.BeaconBlockRoot,
+		SourceRoot:      a.SourceRoot,
+		TargetRoot:      a.TargetRoot,
+		Buckets:         buckets,
+		Attesters:       attesters,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AttestationVoteSummary) UnmarshalJSON(input []byte) error {
+	var data attestationVoteSummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.CommitteeIndex == "" {
+		return errors.New("committee index missing")
+	}
+	committeeIndex, err := strconv.ParseUint(data.CommitteeIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for committee index")
+	}
+	a.CommitteeIndex = phase0.CommitteeIndex(committeeIndex)
+	a.BeaconBlockRoot = data.BeaconBlockRoot
+	a.SourceRoot = data.SourceRoot
+	a.TargetRoot = data.TargetRoot
+	if data.Buckets == nil {
+		return errors.New("buckets missing")
+	}
+	a.Buckets = make(map[string][]bitfield.Bitlist, len(data.Buckets))
+	for source, sourceBuckets := range data.Buckets {
+		a.Buckets[source] = make([]bitfield.Bitlist, len(sourceBuckets))
+		for i := range sourceBuckets {
+			if sourceBuckets[i] == "" {
+				// Empty bucket.
+				continue
+			}
+			bits, err := hex.DecodeString(strings.TrimPrefix(sourceBuckets[i], "0x"))
+			if err != nil {
+				return errors.Wrapf(err, "invalid value for bucket %d of %s", i, source)
+			}
+			a.Buckets[source][i] = bits
+		}
+	}
+	if len(data.Attesters) > 0 {
+		a.Attesters = make(map[string]map[phase0.ValidatorIndex]uint64, len(data.Attesters))
+		for source, sourceAttesters := range data.Attesters {
+			a.Attesters[source] = make(map[phase0.ValidatorIndex]uint64, len(sourceAttesters))
+			for attesterIndexStr, bucketStr := range sourceAttesters {
+				attesterIndex, err := strconv.ParseUint(attesterIndexStr, 10, 64)
+				if err != nil {
+					return errors.Wrapf(err, "invalid attester index for %s", source)
+				}
+				bucket, err := strconv.ParseUint(bucketStr, 10, 64)
+				if err != nil {
+					return errors.Wrapf(err, "invalid bucket for attester %d of %s", attesterIndex, source)
+				}
+				a.Attesters[source][phase0.ValidatorIndex(attesterIndex)] = bucket
+			}
+		}
+	}
+
+	return nil
+}
+
+// AttestationVoteCorrectness is the number of attesters whose votes were correct,
+// had the wrong head, or had the wrong target.  A vote with the wrong target is
+// counted as such regardless of its head.
+type AttestationVoteCorrectness struct {
+	Correct     uint64
+	WrongHead   uint64
+	WrongTarget uint64
+}
+
+// attestationVoteCorrectnessJSON is the wire representation of the struct.
+type attestationVoteCorrectnessJSON struct {
+	Correct     string `json:"correct"`
+	WrongHead   string `json:"wrong_head"`
+	WrongTarget string `json:"wrong_target"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a *AttestationVoteCorrectness) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&attestationVoteCorrectnessJSON{
+		Correct:     strconv.FormatUint(a.Correct, 10),
+		WrongHead:   strconv.FormatUint(a.WrongHead, 10),
+		WrongTarget: strconv.FormatUint(a.WrongTarget, 10),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AttestationVoteCorrectness) UnmarshalJSON(input []byte) error {
+	var data attestationVoteCorrectnessJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Correct == "" {
+		return errors.New("correct missing")
+	}
+	correct, err := strconv.ParseUint(data.Correct, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for correct")
+	}
+	a.Correct = correct
+	if data.WrongHead == "" {
+		return errors.New("wrong head missing")
+	}
+	wrongHead, err := strconv.ParseUint(data.WrongHead, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for wrong head")
+	}
+	a.WrongHead = wrongHead
+	if data.WrongTarget == "" {
+		return errors.New("wrong target missing")
+	}
+	wrongTarget, err := strconv.ParseUint(data.WrongTarget, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for wrong target")
+	}
+	a.WrongTarget = wrongTarget
+
+	return nil
+}
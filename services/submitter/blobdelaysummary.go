@@ -0,0 +1,185 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BlobDelaySummary is a summary of the blob sidecars seen by a node for a block.
+type BlobDelaySummary struct {
+	Source    string
+	Method    string
+	Slot      phase0.Slot
+	BlockRoot phase0.Root
+	// BlockSeen is true if the block event was seen, in which case
+	// BlockDelay and the blobs' SinceBlock values are set.
+	BlockSeen  bool
+	BlockDelay time.Duration
+	Blobs      []*BlobDelay
+}
+
+// blobDelaySummaryJSON is the wire representation of the struct.
+type blobDelaySummaryJSON struct {
+	Source       string       `json:"source"`
+	Method       string       `json:"method"`
+	Slot         string       `json:"slot"`
+	BlockRoot    phase0.Root  `json:"block_root"`
+	BlockDelayMS string       `json:"block_delay_ms,omitempty"`
+	Blobs        []*BlobDelay `json:"blobs"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlobDelaySummary) MarshalJSON() ([]byte, error) {
+	blobs := make([]*BlobDelay, len(b.Blobs))
+	for i := range b.Blobs {
+		// Blobs are marshalled with knowledge of whether the block was seen.
+		blob := *b.Blobs[i]
+		blob.blockSeen = b.BlockSeen
+		blobs[i] = &blob
+	}
+
+	data := &blobDelaySummaryJSON{
+		Source:    b.Source,
+		Method:    b.Method,
+		Slot:      fmt.Sprintf("%d", b.Slot),
+		BlockRoot: b.BlockRoot,
+		Blobs:     blobs,
+	}
+	if b.BlockSeen {
+		data.BlockDelayMS = fmt.Sprintf("%d", b.BlockDelay.Milliseconds())
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlobDelaySummary) UnmarshalJSON(input []byte) error {
+	var data blobDelaySummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Source == "" {
+		return errors.New("source missing")
+	}
+	b.Source = data.Source
+	b.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	b.Slot = phase0.Slot(slot)
+	b.BlockRoot = data.BlockRoot
+	if data.BlockDelayMS != "" {
+		blockDelay, err := strconv.ParseInt(data.BlockDelayMS, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for block delay")
+		}
+		b.BlockSeen = true
+		b.BlockDelay = time.Duration(blockDelay) * time.Millisecond
+	}
+	if data.Blobs == nil {
+		return errors.New("blobs missing")
+	}
+	b.Blobs = data.Blobs
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BlobDelaySummary) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// BlobDelay is the delay of a single blob sidecar.
+type BlobDelay struct {
+	Index uint64
+	// Delay is the time from the start of the slot to receipt of the blob sidecar.
+	Delay time.Duration
+	// SinceBlock is the time from receipt of the block to receipt of the blob
+	// sidecar; it is negative if the blob sidecar arrived first.
+	SinceBlock time.Duration
+
+	blockSeen bool
+}
+
+// blobDelayJSON is the wire representation of the struct.
+type blobDelayJSON struct {
+	Index        string `json:"index"`
+	DelayMS      string `json:"delay_ms"`
+	SinceBlockMS string `json:"since_block_ms,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlobDelay) MarshalJSON() ([]byte, error) {
+	data := &blobDelayJSON{
+		Index:   strconv.FormatUint(b.Index, 10),
+		DelayMS: fmt.Sprintf("%d", b.Delay.Milliseconds()),
+	}
+	if b.blockSeen {
+		data.SinceBlockMS = fmt.Sprintf("%d", b.SinceBlock.Milliseconds())
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlobDelay) UnmarshalJSON(input []byte) error {
+	var data blobDelayJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Index == "" {
+		return errors.New("index missing")
+	}
+	index, err := strconv.ParseUint(data.Index, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for index")
+	}
+	b.Index = index
+	if data.DelayMS == "" {
+		return errors.New("delay missing")
+	}
+	delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for delay")
+	}
+	b.Delay = time.Duration(delay) * time.Millisecond
+	if data.SinceBlockMS != "" {
+		sinceBlock, err := strconv.ParseInt(data.SinceBlockMS, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for since block")
+		}
+		b.blockSeen = true
+		b.SinceBlock = time.Duration(sinceBlock) * time.Millisecond
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BlockDelay is a block delay data point.
+type BlockDelay struct {
+	Source              string
+	Method              string
+	Slot                phase0.Slot
+	BlockRoot           phase0.Root
+	ExecutionOptimistic bool
+	// ProposerKnown is true if the proposer duty for the slot was obtained,
+	// in which case ProposerIndex is set.
+	ProposerKnown bool
+	ProposerIndex phase0.ValidatorIndex
+	// BlockFetched is true if the block was obtained, in which case Size
+	// and BlobCount are set.
+	BlockFetched bool
+	// Size is the size of the SSZ-encoded signed block, in bytes.
+	Size      uint64
+	BlobCount uint64
+	Delay     time.Duration
+}
+
+// blockDelayJSON is the wire representation of the struct.
+type blockDelayJSON struct {
+	Source              string      `json:"source"`
+	Method              string      `json:"method"`
+	Slot                string      `json:"slot"`
+	BlockRoot           phase0.Root `json:"block_root"`
+	ExecutionOptimistic bool        `json:"execution_optimistic"`
+	ProposerIndex       string      `json:"proposer_index,omitempty"`
+	Size                string      `json:"size,omitempty"`
+	BlobCount           string      `json:"blob_count,omitempty"`
+	DelayMS             string      `json:"delay_ms"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlockDelay) MarshalJSON() ([]byte, error) {
+	data := &blockDelayJSON{
+		Source:              b.Source,
+		Method:              b.Method,
+		Slot:                fmt.Sprintf("%d", b.Slot),
+		BlockRoot:           b.BlockRoot,
+		ExecutionOptimistic: b.ExecutionOptimistic,
+		DelayMS:             fmt.Sprintf("%d", b.Delay.Milliseconds()),
+	}
+	if b.ProposerKnown {
+		data.ProposerIndex = fmt.Sprintf("%d", b.ProposerIndex)
+	}
+	if b.BlockFetched {
+		data.Size = strconv.FormatUint(b.Size, 10)
+		data.BlobCount = strconv.FormatUint(b.BlobCount, 10)
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlockDelay) UnmarshalJSON(input []byte) error {
+	var data blockDelayJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Source == "" {
+		return errors.New("source missing")
+	}
+	b.Source = data.Source
+	b.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	b.Slot = phase0.Slot(slot)
+	b.BlockRoot = data.BlockRoot
+	b.ExecutionOptimistic = data.ExecutionOptimistic
+	if data.ProposerIndex != "" {
+		proposerIndex, err := strconv.ParseUint(data.ProposerIndex, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for proposer index")
+		}
+		b.ProposerKnown = true
+		b.ProposerIndex = phase0.ValidatorIndex(proposerIndex)
+	}
+	if data.Size != "" || data.BlobCount != "" {
+		if data.Size == "" {
+			return errors.New("size missing")
+		}
+		b.Size, err = strconv.ParseUint(data.Size, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for size")
+		}
+		if data.BlobCount == "" {
+			return errors.New("blob count missing")
+		}
+		b.BlobCount, err = strconv.ParseUint(data.BlobCount, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for blob count")
+		}
+		b.BlockFetched = true
+	}
+	if data.DelayMS == "" {
+		return errors.New("delay missing")
+	}
+	delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for delay")
+	}
+	b.Delay = time.Duration(delay) * time.Millisecond
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BlockDelay) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,183 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BlockPropagationSummary is a summary of the propagation of a block across nodes.
+type BlockPropagationSummary struct {
+	Method    string
+	Slot      phase0.Slot
+	BlockRoot phase0.Root
+	// FirstSeen is the node that saw the block first.
+	FirstSeen string
+	// Spread is the time between the fastest and slowest nodes to see the block.
+	Spread time.Duration
+	// Nodes are the nodes that saw the block, in the order in which they saw it.
+	Nodes []*BlockPropagationNode
+	// Missing are the nodes that did not see the block.
+	Missing []string
+}
+
+// blockPropagationSummaryJSON is the wire representation of the struct.
+type blockPropagationSummaryJSON struct {
+	Method    string                  `json:"method"`
+	Slot      string                  `json:"slot"`
+	BlockRoot phase0.Root             `json:"block_root"`
+	FirstSeen string                  `json:"first_seen"`
+	SpreadMS  string                  `json:"spread_ms"`
+	Nodes     []*BlockPropagationNode `json:"nodes"`
+	Missing   []string                `json:"missing"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlockPropagationSummary) MarshalJSON() ([]byte, error) {
+	nodes := b.Nodes
+	if nodes == nil {
+		nodes = make([]*BlockPropagationNode, 0)
+	}
+	missing := b.Missing
+	if missing == nil {
+		missing = make([]string, 0)
+	}
+
+	return json.Marshal(&blockPropagationSummaryJSON{
+		Method:    b.Method,
+		Slot:      fmt.Sprintf("%d", b.Slot),
+		BlockRoot: b.BlockRoot,
+		FirstSeen: b.FirstSeen,
+		SpreadMS:  fmt.Sprintf("%d", b.Spread.Milliseconds()),
+		Nodes:     nodes,
+		Missing:   missing,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlockPropagationSummary) UnmarshalJSON(input []byte) error {
+	var data blockPropagationSummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	b.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	b.Slot = phase0.Slot(slot)
+	b.BlockRoot = data.BlockRoot
+	if data.FirstSeen == "" {
+		return errors.New("first seen missing")
+	}
+	b.FirstSeen = data.FirstSeen
+	if data.SpreadMS == "" {
+		return errors.New("spread missing")
+	}
+	spread, err := strconv.ParseInt(data.SpreadMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for spread")
+	}
+	b.Spread = time.Duration(spread) * time.Millisecond
+	if data.Nodes == nil {
+		return errors.New("nodes missing")
+	}
+	b.Nodes = data.Nodes
+	if data.Missing == nil {
+		return errors.New("missing nodes missing")
+	}
+	b.Missing = data.Missing
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *BlockPropagationSummary) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// BlockPropagationNode is the sighting of a block by a single node.
+type BlockPropagationNode struct {
+	Node   string
+	Source string
+	// Rank is the position of the node in the order in which nodes saw the block, starting at 1.
+	Rank uint64
+	// Delay is the time from the start of the slot to receipt of the block.
+	Delay time.Duration
+}
+
+// blockPropagationNodeJSON is the wire representation of the struct.
+type blockPropagationNodeJSON struct {
+	Node    string `json:"node"`
+	Source  string `json:"source"`
+	Rank    string `json:"rank"`
+	DelayMS string `json:"delay_ms"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BlockPropagationNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&blockPropagationNodeJSON{
+		Node:    b.Node,
+		Source:  b.Source,
+		Rank:    strconv.FormatUint(b.Rank, 10),
+		DelayMS: fmt.Sprintf("%d", b.Delay.Milliseconds()),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlockPropagationNode) UnmarshalJSON(input []byte) error {
+	var data blockPropagationNodeJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Node == "" {
+		return errors.New("node missing")
+	}
+	b.Node = data.Node
+	b.Source = data.Source
+	if data.Rank == "" {
+		return errors.New("rank missing")
+	}
+	rank, err := strconv.ParseUint(data.Rank, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for rank")
+	}
+	b.Rank = rank
+	if data.DelayMS == "" {
+		return errors.New("delay missing")
+	}
+	delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for delay")
+	}
+	b.Delay = time.Duration(delay) * time.Millisecond
+
+	return nil
+}
@@ -1,4 +1,4 @@
-// Copyright © 2023 Weald Technology Trading.
+// Copyright © 2023, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,15 +15,13 @@ package console
 
 import (
 	"context"
+	"errors"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/wealdtech/probec/services/metrics"
 )
 
-var (
-	submitterCounter *prometheus.CounterVec
-	submitterTimer   *prometheus.HistogramVec
-)
+var submitterCounter *prometheus.CounterVec
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
 	if submitterCounter != nil {
@@ -49,10 +47,19 @@ func registerPrometheusMetrics(_ context.Context) error {
 		Help:      "Total number of requests submitted",
 	}, []string{"operation", "result"})
 	if err := prometheus.Register(submitterCounter); err != nil {
-		return err
+		// The counter is shared with other submitters, which may have registered it first.
+		var alreadyRegisteredErr prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegisteredErr) {
+			return err
+		}
+		existing, isCounterVec := alreadyRegisteredErr.ExistingCollector.(*prometheus.CounterVec)
+		if !isCounterVec {
+			return err
+		}
+		submitterCounter = existing
 	}
 
-	return prometheus.Register(submitterTimer)
+	return nil
 }
 
 // monitorSubmission is called when a submission has been made.
@@ -0,0 +1,35 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// SubmitDataColumnSummary submits a summary of data column sidecar delays.
+func (*Service) SubmitDataColumnSummary(_ context.Context, data *submitter.DataColumnSummary) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal data column summary")
+		return
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", string(body))
+
+	monitorSubmission("data column summary")
+}
@@ -1,4 +1,4 @@
-// Copyright © 2023 Weald Technology Trading.
+// Copyright © 2023, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,13 +15,21 @@ package console
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+
+	"github.com/wealdtech/probec/services/submitter"
 )
 
 // SubmitHeadDelay submits a head delay data point.
-func (*Service) SubmitHeadDelay(_ context.Context, body string) {
-	fmt.Fprintf(os.Stdout, "%s\n", body)
+func (*Service) SubmitHeadDelay(_ context.Context, data *submitter.HeadDelay) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to marshal head delay")
+		return
+	}
+	fmt.Fprintf(os.Stdout, "%s\n", string(body))
 
 	monitorSubmission("head delay")
 }
@@ -0,0 +1,167 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// DataColumnSummary is a summary of the data column sidecars seen by a node for a block.
+type DataColumnSummary struct {
+	Source    string
+	Method    string
+	Slot      phase0.Slot
+	BlockRoot phase0.Root
+	// BlobCount is the number of blobs in the block.
+	BlobCount uint64
+	// CustodyColumns is the number of columns that the node is expected to custody.
+	CustodyColumns uint64
+	Columns        []*DataColumnDelay
+}
+
+// dataColumnSummaryJSON is the wire representation of the struct.
+type dataColumnSummaryJSON struct {
+	Source         string             `json:"source"`
+	Method         string             `json:"method"`
+	Slot           string             `json:"slot"`
+	BlockRoot      phase0.Root        `json:"block_root"`
+	BlobCount      string             `json:"blob_count"`
+	CustodyColumns string             `json:"custody_columns"`
+	Columns        []*DataColumnDelay `json:"columns"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DataColumnSummary) MarshalJSON() ([]byte, error) {
+	columns := d.Columns
+	if columns == nil {
+		columns = make([]*DataColumnDelay, 0)
+	}
+
+	return json.Marshal(&dataColumnSummaryJSON{
+		Source:         d.Source,
+		Method:         d.Method,
+		Slot:           fmt.Sprintf("%d", d.Slot),
+		BlockRoot:      d.BlockRoot,
+		BlobCount:      strconv.FormatUint(d.BlobCount, 10),
+		CustodyColumns: strconv.FormatUint(d.CustodyColumns, 10),
+		Columns:        columns,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DataColumnSummary) UnmarshalJSON(input []byte) error {
+	var data dataColumnSummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Source == "" {
+		return errors.New("source missing")
+	}
+	d.Source = data.Source
+	d.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	d.Slot = phase0.Slot(slot)
+	d.BlockRoot = data.BlockRoot
+	if data.BlobCount == "" {
+		return errors.New("blob count missing")
+	}
+	d.BlobCount, err = strconv.ParseUint(data.BlobCount, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for blob count")
+	}
+	if data.CustodyColumns == "" {
+		return errors.New("custody columns missing")
+	}
+	d.CustodyColumns, err = strconv.ParseUint(data.CustodyColumns, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for custody columns")
+	}
+	if data.Columns == nil {
+		return errors.New("columns missing")
+	}
+	d.Columns = data.Columns
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (d *DataColumnSummary) String() string {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// DataColumnDelay is the delay of a single data column sidecar.
+type DataColumnDelay struct {
+	Index uint64
+	// Delay is the time from the start of the slot to receipt of the data column sidecar.
+	Delay time.Duration
+}
+
+// dataColumnDelayJSON is the wire representation of the struct.
+type dataColumnDelayJSON struct {
+	Index   string `json:"index"`
+	DelayMS string `json:"delay_ms"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *DataColumnDelay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&dataColumnDelayJSON{
+		Index:   strconv.FormatUint(d.Index, 10),
+		DelayMS: fmt.Sprintf("%d", d.Delay.Milliseconds()),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *DataColumnDelay) UnmarshalJSON(input []byte) error {
+	var data dataColumnDelayJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Index == "" {
+		return errors.New("index missing")
+	}
+	index, err := strconv.ParseUint(data.Index, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for index")
+	}
+	d.Index = index
+	if data.DelayMS == "" {
+		return errors.New("delay missing")
+	}
+	delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for delay")
+	}
+	d.Delay = time.Duration(delay) * time.Millisecond
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fanout
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var dispatchCounter *prometheus.CounterVec
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if dispatchCounter != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	dispatchCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "submitter_fanout",
+		Name:      "dispatches_total",
+		Help:      "Total number of data points dispatched to submitters.",
+	}, []string{"submitter", "type", "result"})
+
+	return prometheus.Register(dispatchCounter)
+}
+
+// monitorDispatch is called when a data point is either sent to or filtered from a submitter.
+func monitorDispatch(submitter string, dataType string, sent bool) {
+	if dispatchCounter == nil {
+		return
+	}
+
+	if sent {
+		dispatchCounter.WithLabelValues(submitter, dataType, "sent").Inc()
+	} else {
+		dispatchCounter.WithLabelValues(submitter, dataType, "filtered").Inc()
+	}
+}
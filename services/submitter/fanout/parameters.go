@@ -0,0 +1,101 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fanout
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/wealdtech/probec/services/metrics"
+	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
+)
+
+type parameters struct {
+	logLevel   zerolog.Level
+	monitor    metrics.Service
+	submitters []*Submitter
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the module.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithSubmitters sets the submitters to which data points are sent.
+func WithSubmitters(submitters []*Submitter) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.submitters = submitters
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		monitor:  nullmetrics.New(),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.monitor == nil {
+		return nil, errors.New("monitor not supplied")
+	}
+	if len(parameters.submitters) == 0 {
+		return nil, errors.New("no submitters supplied")
+	}
+	names := make(map[string]bool, len(parameters.submitters))
+	for _, submitter := range parameters.submitters {
+		if submitter == nil || submitter.Service == nil {
+			return nil, errors.New("submitter service not supplied")
+		}
+		if submitter.Name == "" {
+			return nil, errors.New("submitter name not supplied")
+		}
+		if names[submitter.Name] {
+			return nil, fmt.Errorf("duplicate submitter %s", submitter.Name)
+		}
+		names[submitter.Name] = true
+		for _, dataType := range submitter.Types {
+			if !knownTypes[dataType] {
+				return nil, fmt.Errorf("unknown data type %s for submitter %s", dataType, submitter.Name)
+			}
+		}
+	}
+
+	return &parameters, nil
+}
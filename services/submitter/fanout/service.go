@@ -0,0 +1,139 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fanout
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// Data types that can be used to filter the data points sent to a submitter.
+const (
+	TypeBlockDelay              = "blockdelay"
+	TypeHeadDelay               = "headdelay"
+	TypeAggregateAttestation    = "aggregateattestation"
+	TypeAttestationSummary      = "attestationsummary"
+	TypeReorg                   = "reorg"
+	TypeFinalityDelay           = "finalitydelay"
+	TypeBlobDelaySummary        = "blobdelaysummary"
+	TypeDataColumnSummary       = "datacolumnsummary"
+	TypeSyncCommitteeSummary    = "synccommitteesummary"
+	TypeOperationSummary        = "operationsummary"
+	TypePayloadAttributesDelay  = "payloadattributesdelay"
+	TypeBlockPropagationSummary = "blockpropagationsummary"
+	TypeSlotOutcome             = "slotoutcome"
+)
+
+// knownTypes are the data types that can be used in filters.
+var knownTypes = map[string]bool{
+	TypeBlockDelay:              true,
+	TypeHeadDelay:               true,
+	TypeAggregateAttestation:    true,
+	TypeAttestationSummary:      true,
+	TypeReorg:                   true,
+	TypeFinalityDelay:           true,
+	TypeBlobDelaySummary:        true,
+	TypeDataColumnSummary:       true,
+	TypeSyncCommitteeSummary:    true,
+	TypeOperationSummary:        true,
+	TypePayloadAttributesDelay:  true,
+	TypeBlockPropagationSummary: true,
+	TypeSlotOutcome:             true,
+}
+
+// Submitter is a submitter to which data points are sent, along with the
+// data types that it accepts.
+type Submitter struct {
+	// Name is the name of the submitter, used in logs and metrics.
+	Name string
+	// Service is the submitter service.
+	Service submitter.Service
+	// Types are the data types that the submitter accepts.
+	// If empty then the submitter accepts all data types.
+	Types []string
+}
+
+// child is a submitter along with its compiled filter.
+type child struct {
+	name    string
+	service submitter.Service
+	types   map[string]bool
+}
+
+// accepts returns true if the child accepts the data type.
+func (c *child) accepts(dataType string) bool {
+	return len(c.types) == 0 || c.types[dataType]
+}
+
+// Service is a submitter service that sends data points to multiple submitters.
+type Service struct {
+	log      zerolog.Logger
+	children []*child
+}
+
+// New creates a new fanout submitter service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "submitter").Str("impl", "fanout").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	children := make([]*child, len(parameters.submitters))
+	for i, submitter := range parameters.submitters {
+		types := make(map[string]bool, len(submitter.Types))
+		for _, dataType := range submitter.Types {
+			types[dataType] = true
+		}
+		children[i] = &child{
+			name:    submitter.Name,
+			service: submitter.Service,
+			types:   types,
+		}
+		log.Trace().Str("name", submitter.Name).Strs("types", submitter.Types).Msg("Added submitter")
+	}
+
+	s := &Service{
+		log:      log,
+		children: children,
+	}
+
+	return s, nil
+}
+
+// fanout calls the function for each child that accepts the data type.
+func (s *Service) fanout(dataType string, fn func(service submitter.Service)) {
+	for _, child := range s.children {
+		if !child.accepts(dataType) {
+			monitorDispatch(child.name, dataType, false)
+
+			continue
+		}
+		fn(child.service)
+		monitorDispatch(child.name, dataType, true)
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fanout_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter"
+	"github.com/wealdtech/probec/services/submitter/fanout"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+// recorder is a submitter that records the types of data points it receives.
+type recorder struct {
+	mu       sync.Mutex
+	received []string
+}
+
+func (r *recorder) record(dataType string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.received = append(r.received, dataType)
+}
+
+func (r *recorder) SubmitBlockDelay(_ context.Context, _ *submitter.BlockDelay) {
+	r.record(fanout.TypeBlockDelay)
+}
+
+func (r *recorder) SubmitHeadDelay(_ context.Context, _ *submitter.HeadDelay) {
+	r.record(fanout.TypeHeadDelay)
+}
+
+func (r *recorder) SubmitAggregateAttestation(_ context.Context, _ *submitter.AggregateAttestation) {
+	r.record(fanout.TypeAggregateAttestation)
+}
+
+func (r *recorder) SubmitAttestationSummary(_ context.Context, _ *submitter.AttestationSummary) {
+	r.record(fanout.TypeAttestationSummary)
+}
+
+func (r *recorder) SubmitReorg(_ context.Context, _ *submitter.Reorg) {
+	r.record(fanout.TypeReorg)
+}
+
+func (r *recorder) SubmitFinalityDelay(_ context.Context, _ *submitter.FinalityDelay) {
+	r.record(fanout.TypeFinalityDelay)
+}
+
+func (r *recorder) SubmitBlobDelaySummary(_ context.Context, _ *submitter.BlobDelaySummary) {
+	r.record(fanout.TypeBlobDelaySummary)
+}
+
+func (r *recorder) SubmitDataColumnSummary(_ context.Context, _ *submitter.DataColumnSummary) {
+	r.record(fanout.TypeDataColumnSummary)
+}
+
+func (r *recorder) SubmitSyncCommitteeSummary(_ context.Context, _ *submitter.SyncCommitteeSummary) {
+	r.record(fanout.TypeSyncCommitteeSummary)
+}
+
+func (r *recorder) SubmitOperationSummary(_ context.Context, _ *submitter.OperationSummary) {
+	r.record(fanout.TypeOperationSummary)
+}
+
+func (r *recorder) SubmitPayloadAttributesDelay(_ context.Context, _ *submitter.PayloadAttributesDelay) {
+	r.record(fanout.TypePayloadAttributesDelay)
+}
+
+func (r *recorder) SubmitBlockPropagationSummary(_ context.Context, _ *submitter.BlockPropagationSummary) {
+	r.record(fanout.TypeBlockPropagationSummary)
+}
+
+func (r *recorder) SubmitSlotOutcome(_ context.Context, _ *submitter.SlotOutcome) {
+	r.record(fanout.TypeSlotOutcome)
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []fanout.Parameter
+		err    string
+	}{
+		{
+			name: "MonitorMissing",
+			params: []fanout.Parameter{
+				fanout.WithLogLevel(zerolog.Disabled),
+				fanout.WithMonitor(nil),
+				fanout.WithSubmitters([]*fanout.Submitter{{Name: "test", Service: mocksubmitter.New()}}),
+			},
+			err: "problem with parameters: monitor not supplied",
+		},
+		{
+			name: "SubmittersMissing",
+			params: []fanout.Parameter{
+				fanout.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no submitters supplied",
+		},
+		{
+			name: "SubmitterServiceMissing",
+			params: []fanout.Parameter{
+				fanout.WithLogLevel(zerolog.Disabled),
+				fanout.WithSubmitters([]*fanout.Submitter{{Name: "test"}}),
+			},
+			err: "problem with parameters: submitter service not supplied",
+		},
+		{
+			name: "SubmitterNameMissing",
+			params: []fanout.Parameter{
+				fanout.WithLogLevel(zerolog.Disabled),
+				fanout.WithSubmitters([]*fanout.Submitter{{Service: mocksubmitter.New()}}),
+			},
+			err: "problem with parameters: submitter name not supplied",
+		},
+		{
+			name: "SubmitterDuplicate",
+			params: []fanout.Parameter{
+				fanout.WithLogLevel(zerolog.Disabled),
+				fanout.WithSubmitters([]*fanout.Submitter{
+					{Name: "test", Service: mocksubmitter.New()},
+					{Name: "test", Service: mocksubmitter.New()},
+				}),
+			},
+			err: "problem with parameters: duplicate submitter test",
+		},
+		{
+			name: "TypeUnknown",
+			params: []fanout.Parameter{
+				fanout.WithLogLevel(zerolog.Disabled),
+				fanout.WithSubmitters([]*fanout.Submitter{{Name: "test", Service: mocksubmitter.New(), Types: []string{"unknown"}}}),
+			},
+			err: "problem with parameters: unknown data type unknown for submitter test",
+		},
+		{
+			name: "Good",
+			params: []fanout.Parameter{
+				fanout.WithLogLevel(zerolog.Disabled),
+				fanout.WithSubmitters([]*fanout.Submitter{
+					{Name: "all", Service: mocksubmitter.New()},
+					{Name: "blocks", Service: mocksubmitter.New(), Types: []string{fanout.TypeBlockDelay}},
+				}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := fanout.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	ctx := context.Background()
+
+	all := &recorder{}
+	delays := &recorder{}
+	attestations := &recorder{}
+
+	s, err := fanout.New(ctx,
+		fanout.WithLogLevel(zerolog.Disabled),
+		fanout.WithSubmitters([]*fanout.Submitter{
+			{Name: "all", Service: all},
+			{Name: "delays", Service: delays, Types: []string{fanout.TypeBlockDelay, fanout.TypeHeadDelay}},
+			{Name: "attestations", Service: attestations, Types: []string{fanout.TypeAggregateAttestation, fanout.TypeAttestationSummary}},
+		}),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Slot: 1, Delay: time.Second})
+	s.SubmitHeadDelay(ctx, &submitter.HeadDelay{Source: "test", Slot: 1, Delay: time.Second})
+	s.SubmitAggregateAttestation(ctx, &submitter.AggregateAttestation{Source: "test", Slot: 1})
+	s.SubmitAttestationSummary(ctx, &submitter.AttestationSummary{Slot: 1})
+
+	require.Equal(t, []string{
+		fanout.TypeBlockDelay,
+		fanout.TypeHeadDelay,
+		fanout.TypeAggregateAttestation,
+		fanout.TypeAttestationSummary,
+	}, all.received)
+	require.Equal(t, []string{fanout.TypeBlockDelay, fanout.TypeHeadDelay}, delays.received)
+	require.Equal(t, []string{fanout.TypeAggregateAttestation, fanout.TypeAttestationSummary}, attestations.received)
+}
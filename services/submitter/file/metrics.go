@@ -0,0 +1,85 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	writesCounter    *prometheus.CounterVec
+	rotationsCounter *prometheus.CounterVec
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if writesCounter != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	writesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "submitter_file",
+		Name:      "writes_total",
+		Help:      "Total number of data points written to file.",
+	}, []string{"operation", "result"})
+	if err := prometheus.Register(writesCounter); err != nil {
+		return err
+	}
+
+	rotationsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "submitter_file",
+		Name:      "rotations_total",
+		Help:      "Total number of file rotations.",
+	}, []string{"file", "reason"})
+
+	return prometheus.Register(rotationsCounter)
+}
+
+// monitorWrite is called when a data point has been written.
+func monitorWrite(operation string, succeeded bool) {
+	if writesCounter == nil {
+		return
+	}
+
+	if succeeded {
+		writesCounter.WithLabelValues(operation, "succeeded").Inc()
+	} else {
+		writesCounter.WithLabelValues(operation, "failed").Inc()
+	}
+}
+
+// monitorRotation is called when a file has been rotated.
+func monitorRotation(name string, reason string) {
+	if rotationsCounter == nil {
+		return
+	}
+
+	rotationsCounter.WithLabelValues(name, reason).Inc()
+}
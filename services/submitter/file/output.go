@@ -0,0 +1,207 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// output is a JSON Lines file for a single type of data point.
+type output struct {
+	name string
+	path string
+	file *os.File
+	size int64
+	// opened is the time from which the age of the file is measured.
+	opened time.Time
+	dirty  bool
+}
+
+// write writes a data point to the file for its type, rotating the file if required.
+func (s *Service) write(operation string, name string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		// Files are not reopened after shutdown, as they would not be closed again.
+		monitorWrite(operation, false)
+		s.log.Debug().Str("name", name).Msg("Submitter closed; not writing data")
+
+		return
+	}
+
+	out, exists := s.outputs[name]
+	if !exists {
+		var err error
+		out, err = s.open(name)
+		if err != nil {
+			monitorWrite(operation, false)
+			s.log.Error().Str("name", name).Err(err).Msg("Failed to open file")
+
+			return
+		}
+		s.outputs[name] = out
+	}
+
+	line := make([]byte, 0, len(body)+1)
+	line = append(line, body...)
+	line = append(line, '\n')
+
+	if s.maxSize > 0 && out.size > 0 && out.size+int64(len(line)) > s.maxSize {
+		s.rotate(out, "size")
+	}
+	if out.file == nil {
+		// A previous rotation failed to reopen the file.
+		delete(s.outputs, name)
+		monitorWrite(operation, false)
+
+		return
+	}
+
+	n, err := out.file.Write(line)
+	out.size += int64(n)
+	if err != nil {
+		monitorWrite(operation, false)
+		s.log.Error().Str("file", out.path).Err(err).Msg("Failed to write data")
+
+		return
+	}
+	out.dirty = true
+	if s.syncPolicy == SyncWrite {
+		s.sync(out)
+	}
+	monitorWrite(operation, true)
+}
+
+// open opens the file for the given name, appending to it if it already exists.
+func (s *Service) open(name string) (*output, error) {
+	path := filepath.Join(s.dir, name+".jsonl")
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, errors.Wrap(err, "failed to obtain file information")
+	}
+
+	// The creation time of a file is not portably available, so the age of a
+	// file that is appended to is measured from its last modification.
+	return &output{
+		name:   name,
+		path:   path,
+		file:   file,
+		size:   info.Size(),
+		opened: info.ModTime(),
+	}, nil
+}
+
+// sync syncs the file to disk if it has unsynced data.
+func (s *Service) sync(out *output) {
+	if !out.dirty || out.file == nil {
+		return
+	}
+	if err := out.file.Sync(); err != nil {
+		s.log.Warn().Str("file", out.path).Err(err).Msg("Failed to sync file")
+		return
+	}
+	out.dirty = false
+}
+
+// rotate moves the current file aside and opens a new one in its place.
+func (s *Service) rotate(out *output, reason string) {
+	s.sync(out)
+	if err := out.file.Close(); err != nil {
+		s.log.Warn().Str("file", out.path).Err(err).Msg("Failed to close file")
+	}
+	out.file = nil
+
+	rotatedPath := filepath.Join(s.dir, fmt.Sprintf("%s-%s.jsonl", out.name, time.Now().UTC().Format("20060102T150405.000000000")))
+	if err := os.Rename(out.path, rotatedPath); err != nil {
+		s.log.Error().Str("file", out.path).Err(err).Msg("Failed to rotate file")
+	} else {
+		s.log.Trace().Str("file", rotatedPath).Str("reason", reason).Msg("Rotated file")
+		monitorRotation(out.name, reason)
+		if s.compress {
+			go s.compressFile(rotatedPath)
+		}
+	}
+
+	reopened, err := s.open(out.name)
+	if err != nil {
+		s.log.Error().Str("file", out.path).Err(err).Msg("Failed to reopen file")
+		return
+	}
+	*out = *reopened
+}
+
+// compressFile gzip-compresses a rotated file, removing the original.
+func (s *Service) compressFile(path string) {
+	if err := compressFile(path); err != nil {
+		s.log.Error().Str("file", path).Err(err).Msg("Failed to compress file")
+		return
+	}
+	if err := os.Remove(path); err != nil {
+		s.log.Warn().Str("file", path).Err(err).Msg("Failed to remove compressed file")
+	}
+}
+
+// compressFile writes a gzip-compressed copy of the file alongside it.
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open file")
+	}
+	defer src.Close()
+
+	tmpPath := path + ".gz.tmp"
+	dst, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "failed to create compressed file")
+	}
+	writer := gzip.NewWriter(dst)
+	if _, err := io.Copy(writer, src); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(tmpPath)
+
+		return errors.Wrap(err, "failed to compress file")
+	}
+	if err := writer.Close(); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(tmpPath)
+
+		return errors.Wrap(err, "failed to finalise compressed file")
+	}
+	if err := dst.Sync(); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(tmpPath)
+
+		return errors.Wrap(err, "failed to sync compressed file")
+	}
+	if err := dst.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+
+		return errors.Wrap(err, "failed to close compressed file")
+	}
+
+	return errors.Wrap(os.Rename(tmpPath, path+".gz"), "failed to rename compressed file")
+}
@@ -0,0 +1,214 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file_test
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter"
+	"github.com/wealdtech/probec/services/submitter/file"
+)
+
+// blockDelayLine is the JSON Lines representation of the block delay used in tests.
+const blockDelayLine = `{"source":"test","method":"block event","slot":"1","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"}` + "\n"
+
+// readFiles returns the contents of the files in the directory matching the pattern, decompressing if required.
+func readFiles(t *testing.T, dir string, pattern string) []string {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(dir, pattern))
+	require.NoError(t, err)
+	sort.Strings(paths)
+
+	contents := make([]string, 0, len(paths))
+	for _, path := range paths {
+		file, err := os.Open(path)
+		require.NoError(t, err)
+		var reader io.Reader = file
+		if strings.HasSuffix(path, ".gz") {
+			reader, err = gzip.NewReader(file)
+			require.NoError(t, err)
+		}
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+		contents = append(contents, string(data))
+	}
+
+	return contents
+}
+
+func TestWrite(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	s, err := file.New(ctx,
+		file.WithLogLevel(zerolog.Disabled),
+		file.WithDir(dir),
+		file.WithSyncPolicy(file.SyncWrite),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+	s.SubmitHeadDelay(ctx, &submitter.HeadDelay{Source: "test", Method: "head event", Slot: 2, Delay: 2 * time.Second})
+
+	require.Equal(t, []string{blockDelayLine + blockDelayLine}, readFiles(t, dir, "blockdelay.jsonl"))
+	require.Equal(t, []string{`{"source":"test","method":"head event","slot":"2","delay_ms":"2000"}` + "\n"}, readFiles(t, dir, "headdelay.jsonl"))
+}
+
+func TestAppend(t *testing.T) {
+	dir := t.TempDir()
+
+	for range 2 {
+		ctx, cancel := context.WithCancel(context.Background())
+		s, err := file.New(ctx,
+			file.WithLogLevel(zerolog.Disabled),
+			file.WithDir(dir),
+			file.WithSyncPolicy(file.SyncWrite),
+		)
+		require.NoError(t, err)
+		s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+		cancel()
+	}
+
+	require.Equal(t, []string{blockDelayLine + blockDelayLine}, readFiles(t, dir, "blockdelay.jsonl"))
+}
+
+func TestRotateSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		compress bool
+		pattern  string
+	}{
+		{
+			name:    "Uncompressed",
+			pattern: "blockdelay-*.jsonl",
+		},
+		{
+			name:     "Compressed",
+			compress: true,
+			pattern:  "blockdelay-*.jsonl.gz",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			dir := t.TempDir()
+			s, err := file.New(ctx,
+				file.WithLogLevel(zerolog.Disabled),
+				file.WithDir(dir),
+				file.WithMaxSize(int64(2*len(blockDelayLine))),
+				file.WithCompress(test.compress),
+			)
+			require.NoError(t, err)
+
+			for range 5 {
+				s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: phase0.Slot(1), Delay: time.Second})
+			}
+
+			require.Eventually(t, func() bool {
+				return len(readFiles(t, dir, test.pattern)) == 2
+			}, time.Second, 10*time.Millisecond)
+			require.Equal(t, []string{blockDelayLine + blockDelayLine, blockDelayLine + blockDelayLine}, readFiles(t, dir, test.pattern))
+			require.Equal(t, []string{blockDelayLine}, readFiles(t, dir, "blockdelay.jsonl"))
+		})
+	}
+}
+
+func TestRotateAge(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	s, err := file.New(ctx,
+		file.WithLogLevel(zerolog.Disabled),
+		file.WithDir(dir),
+		file.WithMaxAge(50*time.Millisecond),
+		file.WithSyncInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+
+	require.Eventually(t, func() bool {
+		return len(readFiles(t, dir, "blockdelay-*.jsonl")) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{blockDelayLine}, readFiles(t, dir, "blockdelay-*.jsonl"))
+	require.Equal(t, []string{""}, readFiles(t, dir, "blockdelay.jsonl"))
+}
+
+func TestRotateAgeExisting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A file left by a previous run, last written to an hour ago.
+	dir := t.TempDir()
+	path := filepath.Join(dir, "blockdelay.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(blockDelayLine), 0o600))
+	modified := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(path, modified, modified))
+
+	s, err := file.New(ctx,
+		file.WithLogLevel(zerolog.Disabled),
+		file.WithDir(dir),
+		file.WithMaxAge(time.Minute),
+		file.WithSyncInterval(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// The age of the file is not reset by appending to it.
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+
+	require.Eventually(t, func() bool {
+		return len(readFiles(t, dir, "blockdelay-*.jsonl")) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{blockDelayLine + blockDelayLine}, readFiles(t, dir, "blockdelay-*.jsonl"))
+}
+
+func TestWriteAfterClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	dir := t.TempDir()
+	s, err := file.New(ctx,
+		file.WithLogLevel(zerolog.Disabled),
+		file.WithDir(dir),
+		file.WithSyncPolicy(file.SyncWrite),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+	cancel()
+	// Allow the files to be closed.
+	time.Sleep(100 * time.Millisecond)
+
+	// Data submitted after shutdown is not written.
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+	require.Equal(t, []string{blockDelayLine}, readFiles(t, dir, "blockdelay.jsonl"))
+}
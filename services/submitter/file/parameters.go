@@ -0,0 +1,157 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/wealdtech/probec/services/metrics"
+	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
+)
+
+// SyncPolicy defines when written data is synced to disk.
+type SyncPolicy string
+
+const (
+	// SyncNone leaves syncing to the operating system.
+	SyncNone SyncPolicy = "none"
+	// SyncWrite syncs after every write.
+	SyncWrite SyncPolicy = "write"
+	// SyncInterval syncs at regular intervals.
+	SyncInterval SyncPolicy = "interval"
+)
+
+type parameters struct {
+	logLevel     zerolog.Level
+	monitor      metrics.Service
+	dir          string
+	maxSize      int64
+	maxAge       time.Duration
+	compress     bool
+	syncPolicy   SyncPolicy
+	syncInterval time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the module.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithDir sets the directory in which files are written.
+func WithDir(dir string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dir = dir
+	})
+}
+
+// WithMaxSize sets the size in bytes at which a file is rotated.
+// If 0 then files are not rotated on size.
+func WithMaxSize(size int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxSize = size
+	})
+}
+
+// WithMaxAge sets the age at which a file is rotated.
+// If 0 then files are not rotated on age.
+func WithMaxAge(age time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxAge = age
+	})
+}
+
+// WithCompress sets whether rotated files are gzip-compressed.
+func WithCompress(compress bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.compress = compress
+	})
+}
+
+// WithSyncPolicy sets the policy for syncing written data to disk.
+func WithSyncPolicy(policy SyncPolicy) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncPolicy = policy
+	})
+}
+
+// WithSyncInterval sets the interval between syncs for the interval sync policy.
+func WithSyncInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.syncInterval = interval
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:     zerolog.GlobalLevel(),
+		monitor:      nullmetrics.New(),
+		maxSize:      100 * 1024 * 1024,
+		maxAge:       24 * time.Hour,
+		syncPolicy:   SyncInterval,
+		syncInterval: time.Second,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.monitor == nil {
+		return nil, errors.New("monitor not supplied")
+	}
+	if parameters.dir == "" {
+		return nil, errors.New("directory not supplied")
+	}
+	if parameters.maxSize < 0 {
+		return nil, errors.New("max size cannot be negative")
+	}
+	if parameters.maxAge < 0 {
+		return nil, errors.New("max age cannot be negative")
+	}
+	switch parameters.syncPolicy {
+	case SyncNone, SyncWrite:
+	case SyncInterval:
+		if parameters.syncInterval <= 0 {
+			return nil, errors.New("sync interval must be greater than 0")
+		}
+	default:
+		return nil, fmt.Errorf("unknown sync policy %s", parameters.syncPolicy)
+	}
+
+	return &parameters, nil
+}
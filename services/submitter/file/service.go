@@ -0,0 +1,125 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a submitter service that writes data points to JSON Lines files.
+type Service struct {
+	log          zerolog.Logger
+	dir          string
+	maxSize      int64
+	maxAge       time.Duration
+	compress     bool
+	syncPolicy   SyncPolicy
+	syncInterval time.Duration
+
+	mu      sync.Mutex
+	outputs map[string]*output
+	// closed is set once the files have been closed on shutdown, after
+	// which no further data is written.
+	closed bool
+}
+
+// New creates a new file submitter service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "submitter").Str("impl", "file").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	if err := os.MkdirAll(parameters.dir, 0o700); err != nil {
+		return nil, errors.Wrap(err, "failed to create directory")
+	}
+
+	s := &Service{
+		log:          log,
+		dir:          parameters.dir,
+		maxSize:      parameters.maxSize,
+		maxAge:       parameters.maxAge,
+		compress:     parameters.compress,
+		syncPolicy:   parameters.syncPolicy,
+		syncInterval: parameters.syncInterval,
+		outputs:      make(map[string]*output),
+	}
+
+	go s.maintain(ctx)
+
+	return s, nil
+}
+
+// maintain carries out periodic syncing and rotation of files until the
+// context is cancelled, at which point all files are synced and closed.
+func (s *Service) maintain(ctx context.Context) {
+	period := time.Minute
+	if s.syncPolicy == SyncInterval {
+		period = s.syncInterval
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.closeAll()
+
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			for _, out := range s.outputs {
+				if s.syncPolicy == SyncInterval {
+					s.sync(out)
+				}
+				if s.maxAge > 0 && out.size > 0 && time.Since(out.opened) >= s.maxAge {
+					s.rotate(out, "age")
+				}
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+// closeAll syncs and closes all files.
+func (s *Service) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for name, out := range s.outputs {
+		s.sync(out)
+		if err := out.file.Close(); err != nil {
+			s.log.Warn().Str("file", out.path).Err(err).Msg("Failed to close file")
+		}
+		delete(s.outputs, name)
+	}
+}
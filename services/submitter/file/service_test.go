@@ -0,0 +1,118 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter/file"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []file.Parameter
+		err    string
+	}{
+		{
+			name: "MonitorMissing",
+			params: []file.Parameter{
+				file.WithLogLevel(zerolog.Disabled),
+				file.WithMonitor(nil),
+				file.WithDir(t.TempDir()),
+			},
+			err: "problem with parameters: monitor not supplied",
+		},
+		{
+			name: "DirMissing",
+			params: []file.Parameter{
+				file.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: directory not supplied",
+		},
+		{
+			name: "MaxSizeNegative",
+			params: []file.Parameter{
+				file.WithLogLevel(zerolog.Disabled),
+				file.WithDir(t.TempDir()),
+				file.WithMaxSize(-1),
+			},
+			err: "problem with parameters: max size cannot be negative",
+		},
+		{
+			name: "MaxAgeNegative",
+			params: []file.Parameter{
+				file.WithLogLevel(zerolog.Disabled),
+				file.WithDir(t.TempDir()),
+				file.WithMaxAge(-1),
+			},
+			err: "problem with parameters: max age cannot be negative",
+		},
+		{
+			name: "SyncPolicyUnknown",
+			params: []file.Parameter{
+				file.WithLogLevel(zerolog.Disabled),
+				file.WithDir(t.TempDir()),
+				file.WithSyncPolicy("sometimes"),
+			},
+			err: "problem with parameters: unknown sync policy sometimes",
+		},
+		{
+			name: "SyncIntervalZero",
+			params: []file.Parameter{
+				file.WithLogLevel(zerolog.Disabled),
+				file.WithDir(t.TempDir()),
+				file.WithSyncPolicy(file.SyncInterval),
+				file.WithSyncInterval(0),
+			},
+			err: "problem with parameters: sync interval must be greater than 0",
+		},
+		{
+			name: "Good",
+			params: []file.Parameter{
+				file.WithLogLevel(zerolog.Disabled),
+				file.WithDir(t.TempDir()),
+			},
+		},
+		{
+			name: "GoodSyncWrite",
+			params: []file.Parameter{
+				file.WithLogLevel(zerolog.Disabled),
+				file.WithDir(t.TempDir()),
+				file.WithSyncPolicy(file.SyncWrite),
+				file.WithSyncInterval(0),
+				file.WithMaxSize(0),
+				file.WithMaxAge(time.Hour),
+				file.WithCompress(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := file.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
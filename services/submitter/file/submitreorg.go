@@ -0,0 +1,33 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// SubmitReorg submits a chain reorganisation data point.
+func (s *Service) SubmitReorg(_ context.Context, data *submitter.Reorg) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		monitorWrite("reorg", false)
+		s.log.Error().Err(err).Msg("Failed to marshal reorg")
+		return
+	}
+
+	s.write("reorg", "reorg", body)
+}
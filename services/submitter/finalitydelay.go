@@ -0,0 +1,134 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// FinalityDelay is a finality delay data point.
+type FinalityDelay struct {
+	Source string
+	Method string
+	// Epoch is the finalized epoch.
+	Epoch phase0.Epoch
+	Block phase0.Root
+	State phase0.Root
+	// ObservedEpoch is the epoch in which finality was reported.
+	ObservedEpoch phase0.Epoch
+	// Late is true if finality was reported later than expected.
+	Late bool
+	// Missing is true if finality was not reported in the observed epoch,
+	// in which case Epoch is the epoch that was expected to be finalized
+	// and there is no delay.
+	Missing bool
+	// Delay is the time from the start of the observed epoch to receipt of finality.
+	Delay time.Duration
+}
+
+// finalityDelayJSON is the wire representation of the struct.
+type finalityDelayJSON struct {
+	Source        string      `json:"source"`
+	Method        string      `json:"method"`
+	Epoch         string      `json:"epoch"`
+	Block         phase0.Root `json:"block"`
+	State         phase0.Root `json:"state"`
+	ObservedEpoch string      `json:"observed_epoch"`
+	Late          bool        `json:"late"`
+	Missing       bool        `json:"missing,omitempty"`
+	DelayMS       string      `json:"delay_ms,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *FinalityDelay) MarshalJSON() ([]byte, error) {
+	data := &finalityDelayJSON{
+		Source:        f.Source,
+		Method:        f.Method,
+		Epoch:         fmt.Sprintf("%d", f.Epoch),
+		Block:         f.Block,
+		State:         f.State,
+		ObservedEpoch: fmt.Sprintf("%d", f.ObservedEpoch),
+		Late:          f.Late,
+		Missing:       f.Missing,
+	}
+	// Missing finality has no delay.
+	if !f.Missing {
+		data.DelayMS = fmt.Sprintf("%d", f.Delay.Milliseconds())
+	}
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (f *FinalityDelay) UnmarshalJSON(input []byte) error {
+	var data finalityDelayJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Source == "" {
+		return errors.New("source missing")
+	}
+	f.Source = data.Source
+	f.Method = data.Method
+	if data.Epoch == "" {
+		return errors.New("epoch missing")
+	}
+	epoch, err := strconv.ParseUint(data.Epoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for epoch")
+	}
+	f.Epoch = phase0.Epoch(epoch)
+	f.Block = data.Block
+	f.State = data.State
+	if data.ObservedEpoch == "" {
+		return errors.New("observed epoch missing")
+	}
+	observedEpoch, err := strconv.ParseUint(data.ObservedEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for observed epoch")
+	}
+	f.ObservedEpoch = phase0.Epoch(observedEpoch)
+	f.Late = data.Late
+	f.Missing = data.Missing
+	if data.DelayMS == "" {
+		if !f.Missing {
+			return errors.New("delay missing")
+		}
+	} else {
+		delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid value for delay")
+		}
+		f.Delay = time.Duration(delay) * time.Millisecond
+	}
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (f *FinalityDelay) String() string {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,92 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// HeadDelay is a head delay data point.
+type HeadDelay struct {
+	Source string
+	Method string
+	Slot   phase0.Slot
+	Delay  time.Duration
+}
+
+// headDelayJSON is the wire representation of the struct.
+type headDelayJSON struct {
+	Source  string `json:"source"`
+	Method  string `json:"method"`
+	Slot    string `json:"slot"`
+	DelayMS string `json:"delay_ms"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *HeadDelay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&headDelayJSON{
+		Source:  b.Source,
+		Method:  b.Method,
+		Slot:    fmt.Sprintf("%d", b.Slot),
+		DelayMS: fmt.Sprintf("%d", b.Delay.Milliseconds()),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *HeadDelay) UnmarshalJSON(input []byte) error {
+	var data headDelayJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Source == "" {
+		return errors.New("source missing")
+	}
+	b.Source = data.Source
+	b.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	b.Slot = phase0.Slot(slot)
+	if data.DelayMS == "" {
+		return errors.New("delay missing")
+	}
+	delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for delay")
+	}
+	b.Delay = time.Duration(delay) * time.Millisecond
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (b *HeadDelay) String() string {
+	data, err := json.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
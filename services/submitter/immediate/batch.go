@@ -0,0 +1,196 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+)
+
+// batch is a set of data points awaiting submission to a single endpoint.
+type batch struct {
+	operation string
+	endpoint  string
+	bodies    [][]byte
+}
+
+// payload returns the batch as a JSON array.
+func (b *batch) payload() []byte {
+	size := 2 + len(b.bodies)
+	for i := range b.bodies {
+		size += len(b.bodies[i])
+	}
+	payload := make([]byte, 0, size)
+	payload = append(payload, '[')
+	payload = append(payload, bytes.Join(b.bodies, []byte{','})...)
+
+	return append(payload, ']')
+}
+
+// batcher groups data points by endpoint.
+type batcher struct {
+	maxItems int
+	mu       sync.Mutex
+	batches  map[string]*batch
+}
+
+// newBatcher creates a new batcher.
+func newBatcher(maxItems int) *batcher {
+	return &batcher{
+		maxItems: maxItems,
+		batches:  make(map[string]*batch),
+	}
+}
+
+// add adds a data point to the batch for its endpoint, returning the batch if it is full.
+func (b *batcher) add(operation string, endpoint string, body []byte) *batch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	current, exists := b.batches[endpoint]
+	if !exists {
+		current = &batch{
+			operation: operation,
+			endpoint:  endpoint,
+			bodies:    make([][]byte, 0, b.maxItems),
+		}
+		b.batches[endpoint] = current
+	}
+	current.bodies = append(current.bodies, body)
+	if len(current.bodies) < b.maxItems {
+		return nil
+	}
+	delete(b.batches, endpoint)
+
+	return current
+}
+
+// take removes and returns all pending batches.
+func (b *batcher) take() []*batch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batches := make([]*batch, 0, len(b.batches))
+	for endpoint, current := range b.batches {
+		batches = append(batches, current)
+		delete(b.batches, endpoint)
+	}
+
+	return batches
+}
+
+// dispatch sends a data point to the collectors, either directly or as part of a batch.
+func (s *Service) dispatch(ctx context.Context, operation string, endpoint string, body []byte) {
+	if s.batcher == nil {
+		for _, baseURL := range s.baseURLs {
+			go s.submit(ctx, operation, endpoint, body, baseURL)
+		}
+
+		return
+	}
+
+	if full := s.batcher.add(operation, endpoint, body); full != nil {
+		s.sendBatch(ctx, full, "size")
+	}
+}
+
+// sendBatch sends a batch to the collectors.
+func (s *Service) sendBatch(ctx context.Context, b *batch, reason string) {
+	s.log.Trace().Str("endpoint", b.endpoint).Int("items", len(b.bodies)).Str("reason", reason).Msg("Flushing batch")
+	monitorBatchFlushed(b.operation, reason, len(b.bodies))
+	payload := b.payload()
+	for _, baseURL := range s.baseURLs {
+		go s.submit(ctx, b.operation, b.endpoint, payload, baseURL)
+	}
+}
+
+// flushBatches sends all pending batches to the collectors.
+func (s *Service) flushBatches(ctx context.Context, reason string) {
+	for _, b := range s.batcher.take() {
+		s.sendBatch(ctx, b, reason)
+	}
+}
+
+// shutdownBatches sends all pending batches once the context has been
+// cancelled.  Batches are handed to the queue for a base URL if there is one,
+// so that they are sent on restart; otherwise they are sent immediately.
+func (s *Service) shutdownBatches(ctx context.Context) {
+	// Requests cannot use the cancelled context, but remain bounded by the
+	// collector timeouts.
+	ctx = context.WithoutCancel(ctx)
+
+	var wg sync.WaitGroup
+	for _, b := range s.batcher.take() {
+		s.log.Trace().Str("endpoint", b.endpoint).Int("items", len(b.bodies)).Str("reason", "shutdown").Msg("Flushing batch")
+		monitorBatchFlushed(b.operation, "shutdown", len(b.bodies))
+		payload := b.payload()
+		for _, baseURL := range s.baseURLs {
+			if queue, exists := s.queues[baseURL]; exists {
+				queue.add(b.operation, b.endpoint, payload)
+				continue
+			}
+			wg.Add(1)
+			go func(b *batch, baseURL string) {
+				defer wg.Done()
+				s.submit(ctx, b.operation, b.endpoint, payload, baseURL)
+			}(b, baseURL)
+		}
+	}
+	wg.Wait()
+}
+
+// batchFlusher flushes pending batches at regular intervals and, if
+// chain time is available, at the end of each slot.  Pending batches are
+// flushed when the context is cancelled.
+func (s *Service) batchFlusher(ctx context.Context) {
+	ticker := time.NewTicker(s.batchInterval)
+	defer ticker.Stop()
+
+	slotTimer := time.NewTimer(s.untilNextSlot())
+	defer slotTimer.Stop()
+	if s.chainTime == nil {
+		slotTimer.Stop()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.shutdownBatches(ctx)
+
+			return
+		case <-ticker.C:
+			s.flushBatches(ctx, "interval")
+		case <-slotTimer.C:
+			s.flushBatches(ctx, "slot")
+			slotTimer.Reset(s.untilNextSlot())
+		}
+	}
+}
+
+// untilNextSlot returns the duration until the start of the next slot.
+func (s *Service) untilNextSlot() time.Duration {
+	if s.chainTime == nil {
+		return s.batchInterval
+	}
+
+	wait := time.Until(s.chainTime.StartOfSlot(s.chainTime.CurrentSlot() + 1))
+	if wait <= 0 {
+		// Should not happen, but avoid a busy loop if it does.
+		wait = s.chainTime.SlotDuration()
+	}
+
+	return wait
+}
@@ -0,0 +1,191 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate_test
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter"
+	"github.com/wealdtech/probec/services/submitter/immediate"
+)
+
+// gzipCollector is a test collector that records the decompressed bodies it receives.
+type gzipCollector struct {
+	mu     sync.Mutex
+	bodies map[string][]string
+}
+
+func (c *gzipCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var reader io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		reader = gzipReader
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.mu.Lock()
+	c.bodies[r.URL.Path] = append(c.bodies[r.URL.Path], string(body))
+	c.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *gzipCollector) received(path string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string{}, c.bodies[path]...)
+}
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   []immediate.Parameter
+		points   int
+		expected []string
+	}{
+		{
+			name: "Size",
+			params: []immediate.Parameter{
+				immediate.WithBatchMaxItems(2),
+				immediate.WithBatchInterval(time.Hour),
+			},
+			points: 4,
+			expected: []string{
+				`[{"source":"test","method":"block event","slot":"1","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"},{"source":"test","method":"block event","slot":"2","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"}]`,
+				`[{"source":"test","method":"block event","slot":"3","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"},{"source":"test","method":"block event","slot":"4","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"}]`,
+			},
+		},
+		{
+			name: "Interval",
+			params: []immediate.Parameter{
+				immediate.WithBatchMaxItems(100),
+				immediate.WithBatchInterval(50 * time.Millisecond),
+			},
+			points: 3,
+			expected: []string{
+				`[{"source":"test","method":"block event","slot":"1","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"},{"source":"test","method":"block event","slot":"2","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"},{"source":"test","method":"block event","slot":"3","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"}]`,
+			},
+		},
+		{
+			name: "Compressed",
+			params: []immediate.Parameter{
+				immediate.WithBatchMaxItems(2),
+				immediate.WithBatchInterval(time.Hour),
+				immediate.WithCompress(true),
+			},
+			points: 2,
+			expected: []string{
+				`[{"source":"test","method":"block event","slot":"1","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"},{"source":"test","method":"block event","slot":"2","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"}]`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			c := &gzipCollector{bodies: make(map[string][]string)}
+			server := httptest.NewServer(c)
+			defer server.Close()
+
+			params := append([]immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{server.URL}),
+				immediate.WithBatch(true),
+			}, test.params...)
+			s, err := immediate.New(ctx, params...)
+			require.NoError(t, err)
+
+			for i := 1; i <= test.points; i++ {
+				s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: phase0.Slot(i), Delay: time.Second})
+			}
+
+			require.Eventually(t, func() bool {
+				return len(c.received("/v1/blockdelay")) == len(test.expected)
+			}, time.Second, 10*time.Millisecond)
+			require.ElementsMatch(t, test.expected, c.received("/v1/blockdelay"))
+		})
+	}
+}
+
+func TestBatchShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := &gzipCollector{bodies: make(map[string][]string)}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	s, err := immediate.New(ctx,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{server.URL}),
+		immediate.WithBatch(true),
+		immediate.WithBatchMaxItems(100),
+		immediate.WithBatchInterval(time.Hour),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+
+	// The pending batch is sent when the context is cancelled.
+	cancel()
+	require.Eventually(t, func() bool {
+		return len(c.received("/v1/blockdelay")) == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{
+		`[{"source":"test","method":"block event","slot":"1","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"}]`,
+	}, c.received("/v1/blockdelay"))
+}
+
+func TestBatchShutdownQueued(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	s, err := immediate.New(ctx,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{"http://" + unusedAddress(t)}),
+		immediate.WithQueueDir(dir),
+		immediate.WithBatch(true),
+		immediate.WithBatchMaxItems(100),
+		immediate.WithBatchInterval(time.Hour),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+
+	// The pending batch is handed to the queue when the context is cancelled.
+	cancel()
+	require.Eventually(t, func() bool {
+		return queuedEntries(t, dir) == 1
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,130 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// defaultAPIKeyHeader is the header used to send an API key if no other is configured.
+const defaultAPIKeyHeader = "X-API-Key"
+
+// CollectorConfig is the connection configuration for a single collector.
+type CollectorConfig struct {
+	// BearerToken is sent in the Authorization header if present.
+	BearerToken string
+	// APIKey is sent in the APIKeyHeader header if present.
+	APIKey string
+	// APIKeyHeader is the header in which to send the API key.
+	// Defaults to X-API-Key.
+	APIKeyHeader string
+	// CACertFile is a PEM file containing the CA certificates used to verify the collector.
+	// If not present then the system roots are used.
+	CACertFile string
+	// ClientCertFile is a PEM file containing the client certificate for mutual TLS.
+	ClientCertFile string
+	// ClientKeyFile is a PEM file containing the client key for mutual TLS.
+	ClientKeyFile string
+	// Timeout is the timeout for requests to the collector.
+	// If not present then the service-wide timeout is used.
+	Timeout time.Duration
+	// Proxy is the URL of the proxy through which to connect to the collector.
+	// If not present then the proxy is obtained from the environment.
+	Proxy string
+}
+
+// collector holds the client and headers for a single collector.
+type collector struct {
+	client  *http.Client
+	headers map[string]string
+}
+
+// newCollector creates a collector from its configuration.
+func newCollector(config *CollectorConfig, timeout time.Duration) (*collector, error) {
+	if config == nil {
+		config = &CollectorConfig{}
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.CACertFile != "" {
+		pem, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read CA certificate file")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in CA certificate file")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	switch {
+	case config.ClientCertFile != "" && config.ClientKeyFile != "":
+		cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case config.ClientCertFile != "":
+		return nil, errors.New("client certificate supplied without client key")
+	case config.ClientKeyFile != "":
+		return nil, errors.New("client key supplied without client certificate")
+	}
+
+	defaultTransport, isTransport := http.DefaultTransport.(*http.Transport)
+	if !isTransport {
+		return nil, errors.New("default transport is not an HTTP transport")
+	}
+	transport := defaultTransport.Clone()
+	transport.TLSClientConfig = tlsConfig
+	if config.Proxy != "" {
+		proxy, err := url.Parse(config.Proxy)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid proxy")
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if config.Timeout != 0 {
+		timeout = config.Timeout
+	}
+
+	headers := make(map[string]string)
+	if config.BearerToken != "" {
+		headers["Authorization"] = "Bearer " + config.BearerToken
+	}
+	if config.APIKey != "" {
+		header := config.APIKeyHeader
+		if header == "" {
+			header = defaultAPIKeyHeader
+		}
+		headers[header] = config.APIKey
+	}
+
+	return &collector{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+		},
+		headers: headers,
+	}, nil
+}
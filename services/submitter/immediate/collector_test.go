@@ -0,0 +1,212 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter"
+	"github.com/wealdtech/probec/services/submitter/immediate"
+)
+
+// headerCollector is a test collector that records the headers and client certificates it receives.
+type headerCollector struct {
+	mu          sync.Mutex
+	headers     []http.Header
+	clientCerts []string
+}
+
+func (c *headerCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	c.headers = append(c.headers, r.Header.Clone())
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		c.clientCerts = append(c.clientCerts, r.TLS.PeerCertificates[0].Subject.CommonName)
+	}
+	c.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *headerCollector) received() ([]http.Header, []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]http.Header{}, c.headers...), append([]string{}, c.clientCerts...)
+}
+
+// writeServerCA writes the certificate of a TLS test server to a file, returning its path.
+func writeServerCA(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	return path
+}
+
+// writeClientCert writes a self-signed client certificate and key to files, returning their paths.
+func writeClientCert(t *testing.T, commonName string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "client.pem")
+	keyPath := filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certPath, keyPath
+}
+
+func TestCollectorConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := &headerCollector{}
+	server := httptest.NewUnstartedServer(c)
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAnyClientCert,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	certPath, keyPath := writeClientCert(t, "probec test")
+
+	s, err := immediate.New(ctx,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{server.URL}),
+		immediate.WithCollectorConfigs(map[string]*immediate.CollectorConfig{
+			server.URL: {
+				BearerToken:    "token",
+				APIKey:         "key",
+				APIKeyHeader:   "X-Collector-Key",
+				CACertFile:     writeServerCA(t, server),
+				ClientCertFile: certPath,
+				ClientKeyFile:  keyPath,
+			},
+		}),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+
+	require.Eventually(t, func() bool {
+		headers, _ := c.received()
+		return len(headers) == 1
+	}, time.Second, 10*time.Millisecond)
+	headers, clientCerts := c.received()
+	require.Equal(t, "Bearer token", headers[0].Get("Authorization"))
+	require.Equal(t, "key", headers[0].Get("X-Collector-Key"))
+	require.Equal(t, []string{"probec test"}, clientCerts)
+}
+
+func TestDuplicateBaseURLs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := &headerCollector{}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	s, err := immediate.New(ctx,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{server.URL, server.URL + "/", strings.ToUpper(server.URL)}),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+
+	require.Eventually(t, func() bool {
+		headers, _ := c.received()
+		return len(headers) == 1
+	}, time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	headers, _ := c.received()
+	require.Len(t, headers, 1)
+}
+
+func TestNormaliseBaseURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		output string
+		err    string
+	}{
+		{
+			name:   "Canonical",
+			input:  "https://collector.example.com",
+			output: "https://collector.example.com",
+		},
+		{
+			name:   "TrailingSlash",
+			input:  "https://collector.example.com/",
+			output: "https://collector.example.com",
+		},
+		{
+			name:   "UpperCaseSchemeAndHost",
+			input:  "HTTPS://Collector.Example.com:8080/Path/",
+			output: "https://collector.example.com:8080/Path",
+		},
+		{
+			name:  "Invalid",
+			input: "http://[::1",
+			err:   "invalid base URL http://[::1: parse \"http://[::1\": missing ']' in host",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output, err := immediate.NormaliseBaseURL(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.output, output)
+			}
+		})
+	}
+}
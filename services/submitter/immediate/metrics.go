@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,6 +15,7 @@ package immediate
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,6 +25,12 @@ import (
 var (
 	submitterCounter *prometheus.CounterVec
 	submitterTimer   *prometheus.HistogramVec
+	responseCounter  *prometheus.CounterVec
+	queueEntries     *prometheus.GaugeVec
+	queueDropped     *prometheus.CounterVec
+	queueRetried     *prometheus.CounterVec
+	batchFlushes     *prometheus.CounterVec
+	batchItems       *prometheus.HistogramVec
 )
 
 func registerMetrics(ctx context.Context, monitor metrics.Service) error {
@@ -50,7 +57,16 @@ func registerPrometheusMetrics(_ context.Context) error {
 		Help:      "Total number of requests submitted",
 	}, []string{"operation", "result"})
 	if err := prometheus.Register(submitterCounter); err != nil {
-		return err
+		// The counter is shared with other submitters, which may have registered it first.
+		var alreadyRegisteredErr prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegisteredErr) {
+			return err
+		}
+		existing, isCounterVec := alreadyRegisteredErr.ExistingCollector.(*prometheus.CounterVec)
+		if !isCounterVec {
+			return err
+		}
+		submitterCounter = existing
 	}
 	submitterTimer = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: "probec",
@@ -64,8 +80,69 @@ func registerPrometheusMetrics(_ context.Context) error {
 			3.1, 3.2, 3.3, 3.4, 3.5, 3.6, 3.7, 3.8, 3.9, 4.0,
 		},
 	}, []string{"operation"})
+	if err := prometheus.Register(submitterTimer); err != nil {
+		return err
+	}
+
+	responseCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "submitter",
+		Name:      "responses_total",
+		Help:      "Total number of responses from collectors, by status class",
+	}, []string{"operation", "class"})
+	if err := prometheus.Register(responseCounter); err != nil {
+		return err
+	}
 
-	return prometheus.Register(submitterTimer)
+	queueEntries = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "submitter",
+		Name:      "queue_entries",
+		Help:      "The number of submissions queued for retry.",
+	}, []string{"base_url"})
+	if err := prometheus.Register(queueEntries); err != nil {
+		return err
+	}
+
+	queueDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "submitter",
+		Name:      "queue_dropped_total",
+		Help:      "The number of queued submissions dropped without being sent.",
+	}, []string{"base_url", "reason"})
+	if err := prometheus.Register(queueDropped); err != nil {
+		return err
+	}
+
+	queueRetried = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "submitter",
+		Name:      "queue_retried_total",
+		Help:      "The number of queued submissions successfully sent on retry.",
+	}, []string{"base_url"})
+	if err := prometheus.Register(queueRetried); err != nil {
+		return err
+	}
+
+	batchFlushes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "submitter",
+		Name:      "batch_flushes_total",
+		Help:      "The number of batches flushed, by reason.",
+	}, []string{"operation", "reason"})
+	if err := prometheus.Register(batchFlushes); err != nil {
+		return err
+	}
+
+	batchItems = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "submitter",
+		Name:      "batch_items",
+		Help:      "The number of data points in each batch.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"operation"})
+
+	return prometheus.Register(batchItems)
 }
 
 // monitorSubmission is called when a submission has been made.
@@ -81,3 +158,49 @@ func monitorSubmission(operation string, succeeded bool, delay time.Duration) {
 		submitterCounter.WithLabelValues(operation, "failed").Inc()
 	}
 }
+
+// monitorResponse is called when a response has been received from a collector.
+func monitorResponse(operation string, class string) {
+	if responseCounter == nil {
+		return
+	}
+
+	responseCounter.WithLabelValues(operation, class).Inc()
+}
+
+// monitorQueueEntries is called when the number of entries in a queue changes.
+func monitorQueueEntries(baseURL string, entries int) {
+	if queueEntries == nil {
+		return
+	}
+
+	queueEntries.WithLabelValues(baseURL).Set(float64(entries))
+}
+
+// monitorQueueDropped is called when a queued entry is dropped.
+func monitorQueueDropped(baseURL string, reason string) {
+	if queueDropped == nil {
+		return
+	}
+
+	queueDropped.WithLabelValues(baseURL, reason).Inc()
+}
+
+// monitorQueueRetried is called when a queued entry is successfully sent.
+func monitorQueueRetried(baseURL string) {
+	if queueRetried == nil {
+		return
+	}
+
+	queueRetried.WithLabelValues(baseURL).Inc()
+}
+
+// monitorBatchFlushed is called when a batch is flushed.
+func monitorBatchFlushed(operation string, reason string, items int) {
+	if batchFlushes == nil {
+		return
+	}
+
+	batchFlushes.WithLabelValues(operation, reason).Inc()
+	batchItems.WithLabelValues(operation).Observe(float64(items))
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022, 2023 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,16 +15,32 @@ package immediate
 
 import (
 	"errors"
+	"time"
 
 	"github.com/rs/zerolog"
+	"github.com/wealdtech/probec/services/chaintime"
 	"github.com/wealdtech/probec/services/metrics"
 	nullmetrics "github.com/wealdtech/probec/services/metrics/null"
+	"github.com/wealdtech/probec/signing"
 )
 
 type parameters struct {
-	logLevel zerolog.Level
-	monitor  metrics.Service
-	baseURLs []string
+	logLevel            zerolog.Level
+	monitor             metrics.Service
+	baseURLs            []string
+	collectorConfigs    map[string]*CollectorConfig
+	timeout             time.Duration
+	queueDir            string
+	queueMaxEntries     int
+	queueMaxAge         time.Duration
+	queueInitialBackoff time.Duration
+	queueMaxBackoff     time.Duration
+	batch               bool
+	batchMaxItems       int
+	batchInterval       time.Duration
+	chainTime           chaintime.Service
+	compress            bool
+	signer              signing.Signer
 }
 
 // Parameter is the interface for service parameters.
@@ -59,11 +75,113 @@ func WithBaseURLs(baseUrls []string) Parameter {
 	})
 }
 
+// WithCollectorConfigs sets the connection configuration for collectors, keyed by base URL.
+// Base URLs without a configuration use the defaults.
+func WithCollectorConfigs(configs map[string]*CollectorConfig) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.collectorConfigs = configs
+	})
+}
+
+// WithTimeout sets the timeout for requests to collectors.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// WithQueueDir sets the directory in which failed submissions are queued for retry.
+// If not set then failed submissions are not retried.
+func WithQueueDir(dir string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.queueDir = dir
+	})
+}
+
+// WithQueueMaxEntries sets the maximum number of entries in each retry queue.
+func WithQueueMaxEntries(entries int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.queueMaxEntries = entries
+	})
+}
+
+// WithQueueMaxAge sets the maximum age of entries in each retry queue.
+func WithQueueMaxAge(age time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.queueMaxAge = age
+	})
+}
+
+// WithQueueInitialBackoff sets the initial delay between retries of queued entries.
+func WithQueueInitialBackoff(backoff time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.queueInitialBackoff = backoff
+	})
+}
+
+// WithQueueMaxBackoff sets the maximum delay between retries of queued entries.
+func WithQueueMaxBackoff(backoff time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.queueMaxBackoff = backoff
+	})
+}
+
+// WithBatch sets whether data points are grouped by endpoint and submitted in batches.
+func WithBatch(batch bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.batch = batch
+	})
+}
+
+// WithBatchMaxItems sets the maximum number of data points in a batch.
+func WithBatchMaxItems(items int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.batchMaxItems = items
+	})
+}
+
+// WithBatchInterval sets the maximum time that a data point waits in a batch.
+func WithBatchInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.batchInterval = interval
+	})
+}
+
+// WithChainTime sets the chain time service for this module.
+// If supplied, batches are also flushed at the end of each slot.
+func WithChainTime(service chaintime.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.chainTime = service
+	})
+}
+
+// WithCompress sets whether request bodies are gzip-compressed.
+func WithCompress(compress bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.compress = compress
+	})
+}
+
+// WithSigner sets the signer for request bodies.
+// If not set then request bodies are not signed.
+func WithSigner(signer signing.Signer) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.signer = signer
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		monitor:  nullmetrics.New(),
+		logLevel:            zerolog.GlobalLevel(),
+		monitor:             nullmetrics.New(),
+		timeout:             10 * time.Second,
+		queueMaxEntries:     10000,
+		queueMaxAge:         time.Hour,
+		queueInitialBackoff: time.Second,
+		queueMaxBackoff:     5 * time.Minute,
+		batchMaxItems:       100,
+		batchInterval:       2 * time.Second,
 	}
 	for _, p := range params {
 		if params != nil {
@@ -77,6 +195,31 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if len(parameters.baseURLs) == 0 {
 		return nil, errors.New("base URL not supplied")
 	}
+	if parameters.timeout <= 0 {
+		return nil, errors.New("timeout must be greater than 0")
+	}
+	if parameters.queueDir != "" {
+		if parameters.queueMaxEntries <= 0 {
+			return nil, errors.New("queue max entries must be greater than 0")
+		}
+		if parameters.queueMaxAge <= 0 {
+			return nil, errors.New("queue max age must be greater than 0")
+		}
+		if parameters.queueInitialBackoff <= 0 {
+			return nil, errors.New("queue initial backoff must be greater than 0")
+		}
+		if parameters.queueMaxBackoff < parameters.queueInitialBackoff {
+			return nil, errors.New("queue max backoff cannot be less than queue initial backoff")
+		}
+	}
+	if parameters.batch {
+		if parameters.batchMaxItems <= 0 {
+			return nil, errors.New("batch max items must be greater than 0")
+		}
+		if parameters.batchInterval <= 0 {
+			return nil, errors.New("batch interval must be greater than 0")
+		}
+	}
 
 	return &parameters, nil
 }
@@ -0,0 +1,253 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// queueEntry is a data point awaiting retry.
+type queueEntry struct {
+	Operation string          `json:"operation"`
+	Endpoint  string          `json:"endpoint"`
+	Body      json.RawMessage `json:"body"`
+	Created   time.Time       `json:"created"`
+}
+
+// queue is a disk-backed queue of data points awaiting retry for a single base URL.
+type queue struct {
+	log            zerolog.Logger
+	baseURL        string
+	dir            string
+	maxEntries     int
+	maxAge         time.Duration
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	mu             sync.Mutex
+	entries        []string
+	seq            uint64
+	notify         chan struct{}
+}
+
+// newQueue creates a queue for the base URL, loading any entries left from a previous run.
+func newQueue(log zerolog.Logger,
+	baseURL string,
+	baseDir string,
+	maxEntries int,
+	maxAge time.Duration,
+	initialBackoff time.Duration,
+	maxBackoff time.Duration,
+) (
+	*queue,
+	error,
+) {
+	// Each base URL has its own directory, named from a hash of the URL to keep it filesystem-safe.
+	dir := filepath.Join(baseDir, fmt.Sprintf("%x", sha256.Sum256([]byte(baseURL)))[:16])
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrap(err, "failed to create queue directory")
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read queue directory")
+	}
+	entries := make([]string, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		entries = append(entries, file.Name())
+	}
+	sort.Strings(entries)
+
+	q := &queue{
+		log:            log.With().Str("base_url", baseURL).Logger(),
+		baseURL:        baseURL,
+		dir:            dir,
+		maxEntries:     maxEntries,
+		maxAge:         maxAge,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		entries:        entries,
+		notify:         make(chan struct{}, 1),
+	}
+	if len(entries) > 0 {
+		q.log.Info().Int("entries", len(entries)).Msg("Replaying queued submissions from previous run")
+	}
+	monitorQueueEntries(baseURL, len(entries))
+
+	return q, nil
+}
+
+// add adds a data point to the queue, dropping the oldest entry if the queue is full.
+func (q *queue) add(operation string, endpoint string, body []byte) {
+	data, err := json.Marshal(&queueEntry{
+		Operation: operation,
+		Endpoint:  endpoint,
+		Body:      body,
+		Created:   time.Now(),
+	})
+	if err != nil {
+		q.log.Error().Err(err).Msg("Failed to marshal queue entry")
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.seq++
+	name := fmt.Sprintf("%020d-%08d.json", time.Now().UnixNano(), q.seq)
+	tmpPath := filepath.Join(q.dir, name+".tmp")
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		q.log.Error().Err(err).Msg("Failed to write queue entry")
+		return
+	}
+	if err := os.Rename(tmpPath, filepath.Join(q.dir, name)); err != nil {
+		q.log.Error().Err(err).Msg("Failed to commit queue entry")
+		return
+	}
+	q.entries = append(q.entries, name)
+
+	for len(q.entries) > q.maxEntries {
+		q.log.Debug().Str("entry", q.entries[0]).Msg("Queue full; dropping oldest entry")
+		q.removeLocked(q.entries[0])
+		monitorQueueDropped(q.baseURL, "full")
+	}
+	monitorQueueEntries(q.baseURL, len(q.entries))
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// peek returns the name of the oldest entry in the queue.
+func (q *queue) peek() (string, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.entries) == 0 {
+		return "", false
+	}
+
+	return q.entries[0], true
+}
+
+// load loads an entry from disk.
+func (q *queue) load(name string) (*queueEntry, error) {
+	data, err := os.ReadFile(filepath.Join(q.dir, name))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read queue entry")
+	}
+	entry := &queueEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal queue entry")
+	}
+
+	return entry, nil
+}
+
+// remove removes an entry from the queue.
+func (q *queue) remove(name string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.removeLocked(name)
+	monitorQueueEntries(q.baseURL, len(q.entries))
+}
+
+// removeLocked removes an entry from the queue; the caller must hold the lock.
+func (q *queue) removeLocked(name string) {
+	for i := range q.entries {
+		if q.entries[i] == name {
+			q.entries = append(q.entries[:i], q.entries[i+1:]...)
+			break
+		}
+	}
+	if err := os.Remove(filepath.Join(q.dir, name)); err != nil && !os.IsNotExist(err) {
+		q.log.Warn().Str("entry", name).Err(err).Msg("Failed to remove queue entry")
+	}
+}
+
+// process retries queued entries until the context is cancelled.
+func (q *queue) process(ctx context.Context, post func(context.Context, string, string, string, []byte) error) {
+	backoff := q.initialBackoff
+	for {
+		name, exists := q.peek()
+		if !exists {
+			select {
+			case <-ctx.Done():
+				return
+			case <-q.notify:
+				continue
+			}
+		}
+
+		entry, err := q.load(name)
+		if err != nil {
+			q.log.Warn().Str("entry", name).Err(err).Msg("Invalid queue entry; dropping")
+			q.remove(name)
+			monitorQueueDropped(q.baseURL, "invalid")
+
+			continue
+		}
+		if time.Since(entry.Created) > q.maxAge {
+			q.log.Debug().Str("entry", name).Msg("Queue entry expired; dropping")
+			q.remove(name)
+			monitorQueueDropped(q.baseURL, "expired")
+
+			continue
+		}
+
+		started := time.Now()
+		if err := post(ctx, entry.Operation, q.baseURL, entry.Endpoint, entry.Body); err != nil {
+			monitorSubmission(entry.Operation, false, time.Since(started))
+			if !isRetryable(err) {
+				q.log.Warn().Str("entry", name).Err(err).Msg("Queue entry rejected by collector; dropping")
+				q.remove(name)
+				monitorQueueDropped(q.baseURL, "rejected")
+
+				continue
+			}
+			q.log.Trace().Str("entry", name).Stringer("backoff", backoff).Err(err).Msg("Retry failed")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > q.maxBackoff {
+				backoff = q.maxBackoff
+			}
+
+			continue
+		}
+		monitorSubmission(entry.Operation, true, time.Since(started))
+		monitorQueueRetried(q.baseURL)
+		q.remove(name)
+		backoff = q.initialBackoff
+	}
+}
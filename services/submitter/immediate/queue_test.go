@@ -0,0 +1,211 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter"
+	"github.com/wealdtech/probec/services/submitter/immediate"
+)
+
+// collector is a test collector that records the bodies it receives.
+type collector struct {
+	mu     sync.Mutex
+	bodies map[string][]string
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.mu.Lock()
+	c.bodies[r.URL.Path] = append(c.bodies[r.URL.Path], string(body))
+	c.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *collector) received(path string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]string{}, c.bodies[path]...)
+}
+
+// unusedAddress returns an address on which nothing is listening.
+func unusedAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	return address
+}
+
+// startCollector starts a collector on the given address.
+func startCollector(t *testing.T, address string) *collector {
+	t.Helper()
+
+	c := &collector{bodies: make(map[string][]string)}
+	listener, err := net.Listen("tcp", address)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(c)
+	require.NoError(t, server.Listener.Close())
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	return c
+}
+
+// queuedEntries returns the number of entries in the queue directory.
+func queuedEntries(t *testing.T, dir string) int {
+	t.Helper()
+
+	entries := 0
+	require.NoError(t, filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && filepath.Ext(path) == ".json" {
+			entries++
+		}
+
+		return nil
+	}))
+
+	return entries
+}
+
+func TestQueueRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	address := unusedAddress(t)
+	dir := t.TempDir()
+
+	s, err := immediate.New(ctx,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{"http://" + address}),
+		immediate.WithQueueDir(dir),
+		immediate.WithQueueInitialBackoff(10*time.Millisecond),
+		immediate.WithQueueMaxBackoff(50*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// Collector is down, so the data point should be queued.
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+	require.Eventually(t, func() bool { return queuedEntries(t, dir) == 1 }, time.Second, 10*time.Millisecond)
+
+	// Collector comes up, so the data point should be delivered and the queue emptied.
+	c := startCollector(t, address)
+	require.Eventually(t, func() bool { return len(c.received("/v1/blockdelay")) == 1 }, 2*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return queuedEntries(t, dir) == 0 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, `{"source":"test","method":"block event","slot":"1","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"}`, c.received("/v1/blockdelay")[0])
+}
+
+func TestQueueReplay(t *testing.T) {
+	address := unusedAddress(t)
+	dir := t.TempDir()
+
+	// First run, with the collector down.
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	s, err := immediate.New(ctx1,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{"http://" + address}),
+		immediate.WithQueueDir(dir),
+		immediate.WithQueueInitialBackoff(time.Minute),
+		immediate.WithQueueMaxBackoff(time.Minute),
+	)
+	require.NoError(t, err)
+	s.SubmitHeadDelay(ctx1, &submitter.HeadDelay{Source: "test", Method: "head event", Slot: 1, Delay: time.Second})
+	s.SubmitHeadDelay(ctx1, &submitter.HeadDelay{Source: "test", Method: "head event", Slot: 2, Delay: time.Second})
+	require.Eventually(t, func() bool { return queuedEntries(t, dir) == 2 }, time.Second, 10*time.Millisecond)
+	cancel1()
+
+	// Second run, with the collector up.
+	c := startCollector(t, address)
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	_, err = immediate.New(ctx2,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{"http://" + address}),
+		immediate.WithQueueDir(dir),
+	)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return len(c.received("/v1/headdelay")) == 2 }, 2*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return queuedEntries(t, dir) == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestQueueMaxEntries(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	address := unusedAddress(t)
+	dir := t.TempDir()
+
+	s, err := immediate.New(ctx,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{"http://" + address}),
+		immediate.WithQueueDir(dir),
+		immediate.WithQueueMaxEntries(2),
+		immediate.WithQueueInitialBackoff(time.Minute),
+		immediate.WithQueueMaxBackoff(time.Minute),
+	)
+	require.NoError(t, err)
+
+	for slot := range 5 {
+		s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: phase0.Slot(slot), Delay: time.Second})
+	}
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, 2, queuedEntries(t, dir))
+}
+
+func TestQueueMaxAge(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	address := unusedAddress(t)
+	dir := t.TempDir()
+
+	s, err := immediate.New(ctx,
+		immediate.WithLogLevel(zerolog.Disabled),
+		immediate.WithBaseURLs([]string{"http://" + address}),
+		immediate.WithQueueDir(dir),
+		immediate.WithQueueMaxAge(50*time.Millisecond),
+		immediate.WithQueueInitialBackoff(10*time.Millisecond),
+		immediate.WithQueueMaxBackoff(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+	require.Eventually(t, func() bool { return queuedEntries(t, dir) == 1 }, time.Second, 5*time.Millisecond)
+	require.Eventually(t, func() bool { return queuedEntries(t, dir) == 0 }, time.Second, 10*time.Millisecond)
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,21 +15,35 @@ package immediate
 
 import (
 	"context"
+	"fmt"
 	"net/url"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/signing"
 )
 
-// Service is a fee recipient provider service.
+// Service is a submitter service that submits data to collectors as it arrives,
+// either individually or grouped into batches.
 type Service struct {
-	log      zerolog.Logger
-	baseURLs []string
+	log        zerolog.Logger
+	baseURLs   []string
+	collectors map[string]*collector
+	queues     map[string]*queue
+	compress   bool
+	signer     signing.Signer
+
+	batcher       *batcher
+	batchInterval time.Duration
+	chainTime     chaintime.Service
 }
 
-// New creates a new fee recipient provider service.
+// New creates a new immediate submitter service.
 func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	parameters, err := parseAndCheckParameters(params...)
 	if err != nil {
@@ -46,19 +60,85 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		return nil, errors.New("failed to register metrics")
 	}
 
-	baseURLs := make([]string, len(parameters.baseURLs))
+	baseURLs := make([]string, 0, len(parameters.baseURLs))
 	for i := range parameters.baseURLs {
-		baseURL, err := url.Parse(parameters.baseURLs[i])
+		baseURL, err := NormaliseBaseURL(parameters.baseURLs[i])
+		if err != nil {
+			return nil, err
+		}
+		// Base URLs that differ only in form would receive each data point twice.
+		if !slices.Contains(baseURLs, baseURL) {
+			baseURLs = append(baseURLs, baseURL)
+		}
+	}
+
+	configs := make(map[string]*CollectorConfig, len(parameters.collectorConfigs))
+	for rawBaseURL, config := range parameters.collectorConfigs {
+		baseURL, err := NormaliseBaseURL(rawBaseURL)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(baseURLs, baseURL) {
+			return nil, fmt.Errorf("configuration supplied for unknown base URL %s", baseURL)
+		}
+		configs[baseURL] = config
+	}
+
+	collectors := make(map[string]*collector, len(baseURLs))
+	for _, baseURL := range baseURLs {
+		collector, err := newCollector(configs[baseURL], parameters.timeout)
 		if err != nil {
-			return nil, errors.Wrapf(err, "invalid base URL %s", parameters.baseURLs[i])
+			return nil, errors.Wrapf(err, "invalid configuration for %s", baseURL)
 		}
-		baseURLs[i] = strings.TrimSuffix(baseURL.String(), "/")
+		collectors[baseURL] = collector
 	}
 
 	s := &Service{
-		log:      log,
-		baseURLs: baseURLs,
+		log:        log,
+		baseURLs:   baseURLs,
+		collectors: collectors,
+		queues:     make(map[string]*queue),
+		compress:   parameters.compress,
+		signer:     parameters.signer,
+	}
+
+	if parameters.queueDir != "" {
+		for _, baseURL := range baseURLs {
+			queue, err := newQueue(log,
+				baseURL,
+				parameters.queueDir,
+				parameters.queueMaxEntries,
+				parameters.queueMaxAge,
+				parameters.queueInitialBackoff,
+				parameters.queueMaxBackoff,
+			)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to create queue for %s", baseURL)
+			}
+			s.queues[baseURL] = queue
+			go queue.process(ctx, s.post)
+		}
+	}
+
+	if parameters.batch {
+		s.batcher = newBatcher(parameters.batchMaxItems)
+		s.batchInterval = parameters.batchInterval
+		s.chainTime = parameters.chainTime
+		go s.batchFlusher(ctx)
 	}
 
 	return s, nil
 }
+
+// NormaliseBaseURL returns a base URL in canonical form, with a lower-case
+// scheme and host and without a trailing slash.
+func NormaliseBaseURL(input string) (string, error) {
+	baseURL, err := url.Parse(input)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid base URL %s", input)
+	}
+	baseURL.Scheme = strings.ToLower(baseURL.Scheme)
+	baseURL.Host = strings.ToLower(baseURL.Host)
+
+	return strings.TrimSuffix(baseURL.String(), "/"), nil
+}
@@ -0,0 +1,228 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter/immediate"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []immediate.Parameter
+		err    string
+	}{
+		{
+			name: "MonitorMissing",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithMonitor(nil),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+			},
+			err: "problem with parameters: monitor not supplied",
+		},
+		{
+			name: "BaseURLsMissing",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: base URL not supplied",
+		},
+		{
+			name: "BaseURLInvalid",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://[::1"}),
+			},
+			err: "invalid base URL http://[::1: parse \"http://[::1\": missing ']' in host",
+		},
+		{
+			name: "TimeoutZero",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithTimeout(0),
+			},
+			err: "problem with parameters: timeout must be greater than 0",
+		},
+		{
+			name: "CollectorConfigUnknownBaseURL",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithCollectorConfigs(map[string]*immediate.CollectorConfig{
+					"http://localhost:5678/": {},
+				}),
+			},
+			err: "configuration supplied for unknown base URL http://localhost:5678",
+		},
+		{
+			name: "CollectorConfigCACertMissing",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithCollectorConfigs(map[string]*immediate.CollectorConfig{
+					"http://localhost:1234": {
+						CACertFile: "/nonexistent/ca.pem",
+					},
+				}),
+			},
+			err: "invalid configuration for http://localhost:1234: failed to read CA certificate file: open /nonexistent/ca.pem: no such file or directory",
+		},
+		{
+			name: "CollectorConfigClientKeyMissing",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithCollectorConfigs(map[string]*immediate.CollectorConfig{
+					"http://localhost:1234": {
+						ClientCertFile: "/nonexistent/client.pem",
+					},
+				}),
+			},
+			err: "invalid configuration for http://localhost:1234: client certificate supplied without client key",
+		},
+		{
+			name: "CollectorConfigProxyInvalid",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithCollectorConfigs(map[string]*immediate.CollectorConfig{
+					"http://localhost:1234": {
+						Proxy: "http://[::1",
+					},
+				}),
+			},
+			err: "invalid configuration for http://localhost:1234: invalid proxy: parse \"http://[::1\": missing ']' in host",
+		},
+		{
+			name: "QueueMaxEntriesZero",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithQueueDir(t.TempDir()),
+				immediate.WithQueueMaxEntries(0),
+			},
+			err: "problem with parameters: queue max entries must be greater than 0",
+		},
+		{
+			name: "QueueMaxAgeZero",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithQueueDir(t.TempDir()),
+				immediate.WithQueueMaxAge(0),
+			},
+			err: "problem with parameters: queue max age must be greater than 0",
+		},
+		{
+			name: "QueueInitialBackoffZero",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithQueueDir(t.TempDir()),
+				immediate.WithQueueInitialBackoff(0),
+			},
+			err: "problem with parameters: queue initial backoff must be greater than 0",
+		},
+		{
+			name: "QueueMaxBackoffLow",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithQueueDir(t.TempDir()),
+				immediate.WithQueueInitialBackoff(time.Minute),
+				immediate.WithQueueMaxBackoff(time.Second),
+			},
+			err: "problem with parameters: queue max backoff cannot be less than queue initial backoff",
+		},
+		{
+			name: "BatchMaxItemsZero",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithBatch(true),
+				immediate.WithBatchMaxItems(0),
+			},
+			err: "problem with parameters: batch max items must be greater than 0",
+		},
+		{
+			name: "BatchIntervalZero",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithBatch(true),
+				immediate.WithBatchInterval(0),
+			},
+			err: "problem with parameters: batch interval must be greater than 0",
+		},
+		{
+			name: "Good",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+			},
+		},
+		{
+			name: "GoodQueue",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithQueueDir(t.TempDir()),
+			},
+		},
+		{
+			name: "GoodCollectorConfig",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithCollectorConfigs(map[string]*immediate.CollectorConfig{
+					"http://localhost:1234/": {
+						BearerToken: "token",
+						Timeout:     time.Second,
+						Proxy:       "http://localhost:3128",
+					},
+				}),
+			},
+		},
+		{
+			name: "GoodBatch",
+			params: []immediate.Parameter{
+				immediate.WithLogLevel(zerolog.Disabled),
+				immediate.WithBaseURLs([]string{"http://localhost:1234/"}),
+				immediate.WithBatch(true),
+				immediate.WithCompress(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := immediate.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
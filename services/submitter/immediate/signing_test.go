@@ -0,0 +1,79 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter"
+	"github.com/wealdtech/probec/services/submitter/immediate"
+	"github.com/wealdtech/probec/signing"
+)
+
+func TestSigning(t *testing.T) {
+	keyPEM, publicKey, err := signing.GenerateKey()
+	require.NoError(t, err)
+	privateKey, err := signing.ParsePrivateKey(keyPEM)
+	require.NoError(t, err)
+	signer, err := signing.NewEd25519Signer("", privateKey)
+	require.NoError(t, err)
+
+	verifier := signing.NewVerifier()
+	verifier.AddEd25519Key("", publicKey)
+
+	for _, compress := range []bool{false, true} {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var mu sync.Mutex
+		verified := make([]string, 0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			keyID, body, err := verifier.VerifyRequest(w, r)
+			if err != nil {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			mu.Lock()
+			verified = append(verified, keyID+" "+string(body))
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		s, err := immediate.New(ctx,
+			immediate.WithLogLevel(zerolog.Disabled),
+			immediate.WithBaseURLs([]string{server.URL}),
+			immediate.WithCompress(compress),
+			immediate.WithSigner(signer),
+		)
+		require.NoError(t, err)
+
+		s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return len(verified) == 1
+		}, time.Second, 10*time.Millisecond)
+		require.Equal(t, signing.KeyID(publicKey)+` {"source":"test","method":"block event","slot":"1","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","execution_optimistic":false,"delay_ms":"1000"}`, verified[0])
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wealdtech/probec/signing"
+)
+
+// maxResponseBodyLen is the maximum length of a response body retained for logging.
+const maxResponseBodyLen = 256
+
+// submissionError is an error returned when a submission fails.
+type submissionError struct {
+	err        error
+	statusCode int
+	body       string
+	retryable  bool
+}
+
+// Error implements the error interface.
+func (e *submissionError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *submissionError) Unwrap() error {
+	return e.err
+}
+
+// isRetryable returns true if the error is one for which a later retry may succeed.
+func isRetryable(err error) bool {
+	var submissionErr *submissionError
+	if errors.As(err, &submissionErr) {
+		return submissionErr.retryable
+	}
+
+	return true
+}
+
+// retryableStatus returns true if the HTTP status code is one for which a later retry may succeed.
+func retryableStatus(statusCode int) bool {
+	switch {
+	case statusCode == http.StatusRequestTimeout,
+		statusCode == http.StatusTooManyRequests,
+		statusCode >= http.StatusInternalServerError:
+		return true
+	default:
+		return false
+	}
+}
+
+// statusClass returns the class of the HTTP status code, for example "2xx".
+func statusClass(statusCode int) string {
+	if statusCode < 100 || statusCode > 599 {
+		return "unknown"
+	}
+
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
+
+// submit submits a data point to a base URL, queueing it for retry if the submission fails.
+func (s *Service) submit(ctx context.Context, operation string, endpoint string, body []byte, baseURL string) {
+	started := time.Now()
+
+	if err := s.post(ctx, operation, baseURL, endpoint, body); err != nil {
+		monitorSubmission(operation, false, time.Since(started))
+		retryable := isRetryable(err)
+		e := s.log.Error().Str("base_url", baseURL).Str("operation", operation).Bool("retryable", retryable)
+		var submissionErr *submissionError
+		if errors.As(err, &submissionErr) && submissionErr.statusCode != 0 {
+			e = e.Int("status_code", submissionErr.statusCode).Str("response", submissionErr.body)
+		}
+		e.Err(err).Msg("Failed to submit data")
+		if !retryable {
+			return
+		}
+		if queue, exists := s.queues[baseURL]; exists {
+			queue.add(operation, endpoint, body)
+		}
+
+		return
+	}
+
+	monitorSubmission(operation, true, time.Since(started))
+}
+
+// post posts a body to an endpoint of a base URL.
+func (s *Service) post(ctx context.Context, operation string, baseURL string, endpoint string, body []byte) error {
+	// Signature is over the uncompressed body.
+	uncompressedBody := body
+	if s.compress {
+		var err error
+		body, err = compress(body)
+		if err != nil {
+			return &submissionError{
+				err: errors.Wrap(err, "failed to compress request body"),
+			}
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+endpoint, bytes.NewReader(body))
+	if err != nil {
+		return &submissionError{
+			err: errors.Wrap(err, "failed to create request"),
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.compress {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	if s.signer != nil {
+		// The signature is calculated at the time of each attempt, so that
+		// retries are not rejected as stale.
+		timestamp := time.Now().Unix()
+		signature := s.signer.Sign(signing.Payload(timestamp, req.URL.Path, uncompressedBody))
+		req.Header.Set(signing.KeyIDHeader, s.signer.KeyID())
+		req.Header.Set(signing.TimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(signing.SignatureHeader, fmt.Sprintf("%#x", signature))
+	}
+	collector := s.collectors[baseURL]
+	for k, v := range collector.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := collector.client.Do(req)
+	if err != nil {
+		monitorResponse(operation, "error")
+		return &submissionError{
+			err:       errors.Wrap(err, "failed to send request"),
+			retryable: true,
+		}
+	}
+	defer func() {
+		// Drain the remainder of the body to allow connection reuse.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	monitorResponse(operation, statusClass(resp.StatusCode))
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyLen))
+	if err != nil {
+		respBody = []byte(fmt.Sprintf("<failed to read response body: %v>", err))
+	}
+
+	return &submissionError{
+		err:        fmt.Errorf("collector returned status %d", resp.StatusCode),
+		statusCode: resp.StatusCode,
+		body:       string(bytes.TrimSpace(respBody)),
+		retryable:  retryableStatus(resp.StatusCode),
+	}
+}
+
+// compress gzip-compresses the body.
+func compress(body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(body); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
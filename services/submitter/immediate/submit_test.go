@@ -0,0 +1,137 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immediate_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter"
+	"github.com/wealdtech/probec/services/submitter/immediate"
+	"github.com/wealdtech/probec/testing/logger"
+)
+
+func TestSubmitStatus(t *testing.T) {
+	longBody := strings.Repeat("x", 1000)
+
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		logged     bool
+		response   string
+		queued     bool
+	}{
+		{
+			name:       "OK",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "NoContent",
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:       "BadRequest",
+			statusCode: http.StatusBadRequest,
+			body:       "bad data\n",
+			logged:     true,
+			response:   "bad data",
+		},
+		{
+			name:       "NotFound",
+			statusCode: http.StatusNotFound,
+			body:       longBody,
+			logged:     true,
+			response:   longBody[:256],
+		},
+		{
+			name:       "RequestTimeout",
+			statusCode: http.StatusRequestTimeout,
+			logged:     true,
+			queued:     true,
+		},
+		{
+			name:       "TooManyRequests",
+			statusCode: http.StatusTooManyRequests,
+			logged:     true,
+			queued:     true,
+		},
+		{
+			name:       "InternalServerError",
+			statusCode: http.StatusInternalServerError,
+			body:       "oops",
+			logged:     true,
+			response:   "oops",
+			queued:     true,
+		},
+		{
+			name:       "ServiceUnavailable",
+			statusCode: http.StatusServiceUnavailable,
+			logged:     true,
+			queued:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			capture := logger.NewLogCapture()
+			dir := t.TempDir()
+			s, err := immediate.New(ctx,
+				immediate.WithLogLevel(zerolog.TraceLevel),
+				immediate.WithBaseURLs([]string{server.URL}),
+				immediate.WithQueueDir(dir),
+				immediate.WithQueueInitialBackoff(time.Minute),
+				immediate.WithQueueMaxBackoff(time.Minute),
+			)
+			require.NoError(t, err)
+
+			s.SubmitBlockDelay(ctx, &submitter.BlockDelay{Source: "test", Method: "block event", Slot: 1, Delay: time.Second})
+
+			if test.logged {
+				fields := map[string]any{
+					"message":     "Failed to submit data",
+					"status_code": test.statusCode,
+					"retryable":   test.queued,
+				}
+				if test.response != "" {
+					fields["response"] = test.response
+				}
+				require.Eventually(t, func() bool { return capture.HasLog(fields) }, time.Second, 10*time.Millisecond)
+			} else {
+				time.Sleep(100 * time.Millisecond)
+				require.False(t, capture.HasLog(map[string]any{"message": "Failed to submit data"}))
+			}
+			if test.queued {
+				require.Eventually(t, func() bool { return queuedEntries(t, dir) == 1 }, time.Second, 10*time.Millisecond)
+			} else {
+				require.Equal(t, 0, queuedEntries(t, dir))
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,41 +15,19 @@ package immediate
 
 import (
 	"context"
-	"fmt"
-	"net/http"
-	"strings"
-	"time"
+	"encoding/json"
+
+	"github.com/wealdtech/probec/services/submitter"
 )
 
 // SubmitAttestationSummary submits a summary of attestation data points.
-func (s *Service) SubmitAttestationSummary(ctx context.Context, body string) {
-	for _, baseURL := range s.baseURLs {
-		go s.submitAttestationSummary(ctx, body, baseURL)
-	}
-}
-
-func (s *Service) submitAttestationSummary(ctx context.Context, body string, baseURL string) {
-	started := time.Now()
-
-	url := fmt.Sprintf("%s/v1/attestationsummary", baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(body))
+func (s *Service) SubmitAttestationSummary(ctx context.Context, data *submitter.AttestationSummary) {
+	body, err := json.Marshal(data)
 	if err != nil {
-		monitorSubmission("attestation summary", false, time.Since(started))
-		s.log.Error().Err(err).Msg("Failed to create attestation summary request")
-	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		monitorSubmission("attestation summary", false, time.Since(started))
-		s.log.Error().Err(err).Msg("Failed to send attestation summary request")
-	}
-
-	if resp != nil && resp.Body != nil {
-		if err := resp.Body.Close(); err != nil {
-			monitorSubmission("attestation summary", false, time.Since(started))
-			return
-		}
+		monitorSubmission("attestation summary", false, 0)
+		s.log.Error().Err(err).Msg("Failed to marshal attestation summary")
+		return
 	}
 
-	monitorSubmission("attestation summary", true, time.Since(started))
+	s.dispatch(ctx, "attestation summary", "/v1/attestationsummary", body)
 }
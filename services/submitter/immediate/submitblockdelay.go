@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,41 +15,19 @@ package immediate
 
 import (
 	"context"
-	"fmt"
-	"net/http"
-	"strings"
-	"time"
+	"encoding/json"
+
+	"github.com/wealdtech/probec/services/submitter"
 )
 
 // SubmitBlockDelay submits a block delay data point.
-func (s *Service) SubmitBlockDelay(ctx context.Context, body string) {
-	for _, baseURL := range s.baseURLs {
-		go s.submitBlockDelay(ctx, body, baseURL)
-	}
-}
-
-func (s *Service) submitBlockDelay(ctx context.Context, body string, baseURL string) {
-	started := time.Now()
-
-	url := fmt.Sprintf("%s/v1/blockdelay", baseURL)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(body))
+func (s *Service) SubmitBlockDelay(ctx context.Context, data *submitter.BlockDelay) {
+	body, err := json.Marshal(data)
 	if err != nil {
-		monitorSubmission("block delay", false, time.Since(started))
-		s.log.Error().Err(err).Msg("Failed to create block delay request")
-	}
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		monitorSubmission("block delay", false, time.Since(started))
-		s.log.Error().Err(err).Msg("Failed to send block delay request")
-	}
-
-	if resp != nil && resp.Body != nil {
-		if err := resp.Body.Close(); err != nil {
-			monitorSubmission("block delay", false, time.Since(started))
-			return
-		}
+		monitorSubmission("block delay", false, 0)
+		s.log.Error().Err(err).Msg("Failed to marshal block delay")
+		return
 	}
 
-	monitorSubmission("block delay", true, time.Since(started))
+	s.dispatch(ctx, "block delay", "/v1/blockdelay", body)
 }
@@ -0,0 +1,707 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+func TestBlockDelayJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "Empty",
+			input: []byte{},
+			err:   "unexpected end of JSON input",
+		},
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false,"delay_ms":"123"}`),
+			err:   "source missing",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"source":"test","method":"block event","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false,"delay_ms":"123"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"source":"test","method":"block event","slot":"-1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false,"delay_ms":"123"}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "DelayMissing",
+			input: []byte(`{"source":"test","method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false}`),
+			err:   "delay missing",
+		},
+		{
+			name:  "ProposerIndexInvalid",
+			input: []byte(`{"source":"test","method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false,"proposer_index":"x","delay_ms":"123"}`),
+			err:   "invalid value for proposer index: strconv.ParseUint: parsing \"x\": invalid syntax",
+		},
+		{
+			name:  "SizeMissing",
+			input: []byte(`{"source":"test","method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false,"blob_count":"3","delay_ms":"123"}`),
+			err:   "size missing",
+		},
+		{
+			name:  "BlobCountMissing",
+			input: []byte(`{"source":"test","method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false,"size":"123456","delay_ms":"123"}`),
+			err:   "blob count missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source":"test","method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":false,"delay_ms":"123"}`),
+		},
+		{
+			name:  "GoodDetails",
+			input: []byte(`{"source":"test","method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","execution_optimistic":true,"proposer_index":"12345","size":"123456","blob_count":"3","delay_ms":"123"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.BlockDelay
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestAggregateAttestationJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "CommitteeIndexMissing",
+			input: []byte(`{"source":"test","method":"attestation event","slot":"1","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","aggregation_bits":"0x0f","delay_ms":"4000"}`),
+			err:   "committee index missing",
+		},
+		{
+			name:  "AggregationBitsInvalid",
+			input: []byte(`{"source":"test","method":"attestation event","slot":"1","committee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","aggregation_bits":"0xzz","delay_ms":"4000"}`),
+			err:   "invalid value for aggregation bits: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "CommitteeIndicesInvalid",
+			input: []byte(`{"source":"test","method":"attestation event","slot":"1","committee_index":"2","committee_indices":["2","x"],"beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","aggregation_bits":"0x0f","delay_ms":"4000"}`),
+			err:   "invalid value for committee indices: strconv.ParseUint: parsing \"x\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source":"test","method":"attestation event","slot":"1","committee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","aggregation_bits":"0x0f","delay_ms":"4000"}`),
+		},
+		{
+			name:  "GoodCommitteeIndices",
+			input: []byte(`{"source":"test","method":"attestation event","slot":"1","committee_index":"2","committee_indices":["2","5"],"beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","aggregation_bits":"0x0f01","delay_ms":"4000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.AggregateAttestation
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestAttestationSummaryJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SchemaVersionMissing",
+			input: []byte(`{"method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[]}`),
+			err:   "schema version missing",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"schema_version":"3","method":"attestation event","bucket_width_ms":"100","attestations":[]}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "BucketWidthMissing",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","attestations":[]}`),
+			err:   "bucket width missing",
+		},
+		{
+			name:  "AttestationsMissing",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100"}`),
+			err:   "attestations missing",
+		},
+		{
+			name:  "BucketsMissing",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[{"committee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303"}]}`),
+			err:   "invalid JSON: buckets missing",
+		},
+		{
+			name:  "Empty",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[]}`),
+		},
+		{
+			name:  "AttesterIndexInvalid",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[{"committee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","buckets":{},"attesters":{"test":{"x":"1"}}}]}`),
+			err:   "invalid JSON: invalid attester index for test: strconv.ParseUint: parsing \"x\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[{"committee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","buckets":{"test":["","0x0102",""]}}]}`),
+		},
+		{
+			name:  "GoodAttesters",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[{"committee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","buckets":{},"attesters":{"test":{"12":"3","345":"0"}}}]}`),
+		},
+		{
+			name:  "CorrectnessWrongHeadMissing",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[],"correctness":{"test":{"correct":"10","wrong_target":"1"}}}`),
+			err:   "invalid JSON: wrong head missing",
+		},
+		{
+			name:  "CorrectnessCorrectInvalid",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[],"correctness":{"test":{"correct":"x","wrong_head":"2","wrong_target":"1"}}}`),
+			err:   "invalid JSON: invalid value for correct: strconv.ParseUint: parsing \"x\": invalid syntax",
+		},
+		{
+			name:  "GoodCorrectness",
+			input: []byte(`{"schema_version":"3","method":"attestation event","slot":"1","bucket_width_ms":"100","attestations":[{"committee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","source_root":"0x0202020202020202020202020202020202020202020202020202020202020202","target_root":"0x0303030303030303030303030303030303030303030303030303030303030303","buckets":{"test":["","0x0102",""]}}],"correctness":{"test":{"correct":"10","wrong_head":"2","wrong_target":"1"}}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.AttestationSummary
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestReorgJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"method":"chain reorg event","slot":"10","depth":"2","old_head_block":"0x0101010101010101010101010101010101010101010101010101010101010101","new_head_block":"0x0202020202020202020202020202020202020202020202020202020202020202","old_head_state":"0x0303030303030303030303030303030303030303030303030303030303030303","new_head_state":"0x0404040404040404040404040404040404040404040404040404040404040404","epoch":"0","delay_ms":"4000"}`),
+			err:   "source missing",
+		},
+		{
+			name:  "DepthMissing",
+			input: []byte(`{"source":"test","method":"chain reorg event","slot":"10","old_head_block":"0x0101010101010101010101010101010101010101010101010101010101010101","new_head_block":"0x0202020202020202020202020202020202020202020202020202020202020202","old_head_state":"0x0303030303030303030303030303030303030303030303030303030303030303","new_head_state":"0x0404040404040404040404040404040404040404040404040404040404040404","epoch":"0","delay_ms":"4000"}`),
+			err:   "depth missing",
+		},
+		{
+			name:  "DepthInvalid",
+			input: []byte(`{"source":"test","method":"chain reorg event","slot":"10","depth":"-2","old_head_block":"0x0101010101010101010101010101010101010101010101010101010101010101","new_head_block":"0x0202020202020202020202020202020202020202020202020202020202020202","old_head_state":"0x0303030303030303030303030303030303030303030303030303030303030303","new_head_state":"0x0404040404040404040404040404040404040404040404040404040404040404","epoch":"0","delay_ms":"4000"}`),
+			err:   "invalid value for depth: strconv.ParseUint: parsing \"-2\": invalid syntax",
+		},
+		{
+			name:  "EpochMissing",
+			input: []byte(`{"source":"test","method":"chain reorg event","slot":"10","depth":"2","old_head_block":"0x0101010101010101010101010101010101010101010101010101010101010101","new_head_block":"0x0202020202020202020202020202020202020202020202020202020202020202","old_head_state":"0x0303030303030303030303030303030303030303030303030303030303030303","new_head_state":"0x0404040404040404040404040404040404040404040404040404040404040404","delay_ms":"4000"}`),
+			err:   "epoch missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source":"test","method":"chain reorg event","slot":"10","depth":"2","old_head_block":"0x0101010101010101010101010101010101010101010101010101010101010101","new_head_block":"0x0202020202020202020202020202020202020202020202020202020202020202","old_head_state":"0x0303030303030303030303030303030303030303030303030303030303030303","new_head_state":"0x0404040404040404040404040404040404040404040404040404040404040404","epoch":"0","delay_ms":"4000"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.Reorg
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestFinalityDelayJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"method":"finalized checkpoint event","epoch":"8","block":"0x0101010101010101010101010101010101010101010101010101010101010101","state":"0x0202020202020202020202020202020202020202020202020202020202020202","observed_epoch":"10","late":false,"delay_ms":"4000"}`),
+			err:   "source missing",
+		},
+		{
+			name:  "EpochMissing",
+			input: []byte(`{"source":"test","method":"finalized checkpoint event","block":"0x0101010101010101010101010101010101010101010101010101010101010101","state":"0x0202020202020202020202020202020202020202020202020202020202020202","observed_epoch":"10","late":false,"delay_ms":"4000"}`),
+			err:   "epoch missing",
+		},
+		{
+			name:  "ObservedEpochMissing",
+			input: []byte(`{"source":"test","method":"finalized checkpoint event","epoch":"8","block":"0x0101010101010101010101010101010101010101010101010101010101010101","state":"0x0202020202020202020202020202020202020202020202020202020202020202","late":false,"delay_ms":"4000"}`),
+			err:   "observed epoch missing",
+		},
+		{
+			name:  "ObservedEpochInvalid",
+			input: []byte(`{"source":"test","method":"finalized checkpoint event","epoch":"8","block":"0x0101010101010101010101010101010101010101010101010101010101010101","state":"0x0202020202020202020202020202020202020202020202020202020202020202","observed_epoch":"-1","late":false,"delay_ms":"4000"}`),
+			err:   "invalid value for observed epoch: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source":"test","method":"finalized checkpoint event","epoch":"8","block":"0x0101010101010101010101010101010101010101010101010101010101010101","state":"0x0202020202020202020202020202020202020202020202020202020202020202","observed_epoch":"10","late":true,"delay_ms":"4000"}`),
+		},
+		{
+			name:  "GoodMissing",
+			input: []byte(`{"source":"test","method":"finality missing","epoch":"8","block":"0x0000000000000000000000000000000000000000000000000000000000000000","state":"0x0000000000000000000000000000000000000000000000000000000000000000","observed_epoch":"10","late":true,"missing":true}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.FinalityDelay
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestBlobDelaySummaryJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"method":"blob sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","block_delay_ms":"1000","blobs":[]}`),
+			err:   "source missing",
+		},
+		{
+			name:  "BlockDelayInvalid",
+			input: []byte(`{"source":"test","method":"blob sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","block_delay_ms":"x","blobs":[]}`),
+			err:   "invalid value for block delay: strconv.ParseInt: parsing \"x\": invalid syntax",
+		},
+		{
+			name:  "BlobsMissing",
+			input: []byte(`{"source":"test","method":"blob sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","block_delay_ms":"1000"}`),
+			err:   "blobs missing",
+		},
+		{
+			name:  "BlobIndexMissing",
+			input: []byte(`{"source":"test","method":"blob sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","block_delay_ms":"1000","blobs":[{"delay_ms":"1200","since_block_ms":"200"}]}`),
+			err:   "invalid JSON: index missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source":"test","method":"blob sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","block_delay_ms":"1000","blobs":[{"index":"0","delay_ms":"1200","since_block_ms":"200"},{"index":"1","delay_ms":"900","since_block_ms":"-100"}]}`),
+		},
+		{
+			name:  "GoodBlockNotSeen",
+			input: []byte(`{"source":"test","method":"blob sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","blobs":[{"index":"0","delay_ms":"1200"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.BlobDelaySummary
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestDataColumnSummaryJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"method":"data column sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","blob_count":"3","custody_columns":"4","columns":[]}`),
+			err:   "source missing",
+		},
+		{
+			name:  "BlobCountMissing",
+			input: []byte(`{"source":"test","method":"data column sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","custody_columns":"4","columns":[]}`),
+			err:   "blob count missing",
+		},
+		{
+			name:  "CustodyColumnsMissing",
+			input: []byte(`{"source":"test","method":"data column sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","blob_count":"3","columns":[]}`),
+			err:   "custody columns missing",
+		},
+		{
+			name:  "ColumnsMissing",
+			input: []byte(`{"source":"test","method":"data column sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","blob_count":"3","custody_columns":"4"}`),
+			err:   "columns missing",
+		},
+		{
+			name:  "ColumnDelayMissing",
+			input: []byte(`{"source":"test","method":"data column sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","blob_count":"3","custody_columns":"4","columns":[{"index":"5"}]}`),
+			err:   "invalid JSON: delay missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source":"test","method":"data column sidecar event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","blob_count":"3","custody_columns":"4","columns":[{"index":"5","delay_ms":"1500"},{"index":"77","delay_ms":"1700"}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.DataColumnSummary
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestSyncCommitteeSummaryJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"method":"contribution and proof event","contributions":[]}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "ContributionsMissing",
+			input: []byte(`{"method":"contribution and proof event","slot":"1"}`),
+			err:   "contributions missing",
+		},
+		{
+			name:  "SubcommitteeIndexMissing",
+			input: []byte(`{"method":"contribution and proof event","slot":"1","contributions":[{"beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","nodes":{}}]}`),
+			err:   "invalid JSON: subcommittee index missing",
+		},
+		{
+			name:  "NodesMissing",
+			input: []byte(`{"method":"contribution and proof event","slot":"1","contributions":[{"subcommittee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101"}]}`),
+			err:   "invalid JSON: nodes missing",
+		},
+		{
+			name:  "AggregationBitsInvalid",
+			input: []byte(`{"method":"contribution and proof event","slot":"1","contributions":[{"subcommittee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","nodes":{"test":{"contributions":"3","first_delay_ms":"8100","aggregation_bits":"0xzz","participation":"0"}}}]}`),
+			err:   "invalid JSON: invalid JSON: invalid value for aggregation bits: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name:  "Empty",
+			input: []byte(`{"method":"contribution and proof event","slot":"1","contributions":[]}`),
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"method":"contribution and proof event","slot":"1","contributions":[{"subcommittee_index":"2","beacon_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","nodes":{"test":{"contributions":"3","first_delay_ms":"8100","aggregation_bits":"0x0f000000000000000000000000000001","participation":"5"}}}]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.SyncCommitteeSummary
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestOperationSummaryJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "TypeMissing",
+			input: []byte(`{"method":"operation events","root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen_ms":"1700000000000","sightings":{}}`),
+			err:   "type missing",
+		},
+		{
+			name:  "FirstSeenMissing",
+			input: []byte(`{"method":"operation events","type":"voluntary_exit","root":"0x0101010101010101010101010101010101010101010101010101010101010101","sightings":{}}`),
+			err:   "first seen missing",
+		},
+		{
+			name:  "SightingsMissing",
+			input: []byte(`{"method":"operation events","type":"voluntary_exit","root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen_ms":"1700000000000"}`),
+			err:   "sightings missing",
+		},
+		{
+			name:  "SinceFirstMissing",
+			input: []byte(`{"method":"operation events","type":"voluntary_exit","root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen_ms":"1700000000000","sightings":{"test":{}}}`),
+			err:   "invalid JSON: since first missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"method":"operation events","type":"voluntary_exit","root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen_ms":"1700000000000","sightings":{"test1":{"since_first_ms":"0"},"test2":{"since_first_ms":"350"}}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.OperationSummary
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestPayloadAttributesDelayJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SourceMissing",
+			input: []byte(`{"method":"payload attributes event","proposal_slot":"2","proposer_index":"3","parent_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","events":"1","delay_ms":"-8000","parent_matches_head":true}`),
+			err:   "source missing",
+		},
+		{
+			name:  "ProposalSlotMissing",
+			input: []byte(`{"source":"test","method":"payload attributes event","proposer_index":"3","parent_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","events":"1","delay_ms":"-8000","parent_matches_head":true}`),
+			err:   "proposal slot missing",
+		},
+		{
+			name:  "ProposerIndexMissing",
+			input: []byte(`{"source":"test","method":"payload attributes event","proposal_slot":"2","parent_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","events":"1","delay_ms":"-8000","parent_matches_head":true}`),
+			err:   "proposer index missing",
+		},
+		{
+			name:  "EventsMissing",
+			input: []byte(`{"source":"test","method":"payload attributes event","proposal_slot":"2","proposer_index":"3","parent_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","delay_ms":"-8000","parent_matches_head":true}`),
+			err:   "events missing",
+		},
+		{
+			name:  "DelayMissing",
+			input: []byte(`{"source":"test","method":"payload attributes event","proposal_slot":"2","proposer_index":"3","parent_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","events":"1","parent_matches_head":true}`),
+			err:   "delay missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"source":"test","method":"payload attributes event","proposal_slot":"2","proposer_index":"3","parent_block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","events":"2","delay_ms":"-8000","parent_matches_head":false}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.PayloadAttributesDelay
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestBlockPropagationSummaryJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"method":"block event","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen":"a:5052","spread_ms":"150","nodes":[],"missing":[]}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "FirstSeenMissing",
+			input: []byte(`{"method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","spread_ms":"150","nodes":[],"missing":[]}`),
+			err:   "first seen missing",
+		},
+		{
+			name:  "SpreadMissing",
+			input: []byte(`{"method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen":"a:5052","nodes":[],"missing":[]}`),
+			err:   "spread missing",
+		},
+		{
+			name:  "NodesMissing",
+			input: []byte(`{"method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen":"a:5052","spread_ms":"150","missing":[]}`),
+			err:   "nodes missing",
+		},
+		{
+			name:  "NodeRankMissing",
+			input: []byte(`{"method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen":"a:5052","spread_ms":"150","nodes":[{"node":"a:5052","source":"test","delay_ms":"1000"}],"missing":[]}`),
+			err:   "invalid JSON: rank missing",
+		},
+		{
+			name:  "MissingMissing",
+			input: []byte(`{"method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen":"a:5052","spread_ms":"150","nodes":[]}`),
+			err:   "missing nodes missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"method":"block event","slot":"1","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","first_seen":"a:5052","spread_ms":"150","nodes":[{"node":"a:5052","source":"test","rank":"1","delay_ms":"1000"},{"node":"b:5052","source":"test","rank":"2","delay_ms":"1150"}],"missing":["c:5052"]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.BlockPropagationSummary
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestSlotOutcomeJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"method":"slot check","outcome":"missed","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","seen":[],"missing":["a:5052"]}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"method":"slot check","slot":"-1","outcome":"missed","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","seen":[],"missing":["a:5052"]}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "OutcomeMissing",
+			input: []byte(`{"method":"slot check","slot":"1","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","seen":[],"missing":["a:5052"]}`),
+			err:   "outcome missing",
+		},
+		{
+			name:  "OutcomeInvalid",
+			input: []byte(`{"method":"slot check","slot":"1","outcome":"late","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","seen":[],"missing":["a:5052"]}`),
+			err:   "invalid value for outcome",
+		},
+		{
+			name:  "SeenMissing",
+			input: []byte(`{"method":"slot check","slot":"1","outcome":"missed","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","missing":["a:5052"]}`),
+			err:   "seen missing",
+		},
+		{
+			name:  "MissingMissing",
+			input: []byte(`{"method":"slot check","slot":"1","outcome":"missed","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","seen":[]}`),
+			err:   "missing nodes missing",
+		},
+		{
+			name:  "Missed",
+			input: []byte(`{"method":"slot check","slot":"1","outcome":"missed","block_root":"0x0000000000000000000000000000000000000000000000000000000000000000","seen":[],"missing":["a:5052","b:5052"]}`),
+		},
+		{
+			name:  "Orphaned",
+			input: []byte(`{"method":"slot check","slot":"1","outcome":"orphaned","block_root":"0x0101010101010101010101010101010101010101010101010101010101010101","seen":["a:5052"],"missing":["b:5052"]}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res submitter.SlotOutcome
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+				require.Equal(t, string(rt), res.String())
+			}
+		})
+	}
+}
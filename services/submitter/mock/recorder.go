@@ -0,0 +1,239 @@
+// Copyright © 2022, 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"sync"
+
+	submitter "github.com/wealdtech/probec/services/submitter"
+)
+
+// Recorder is a mock submitter that records the data points submitted to it.
+type Recorder struct {
+	mu                        sync.Mutex
+	blockDelays               []*submitter.BlockDelay
+	headDelays                []*submitter.HeadDelay
+	aggregateAttestations     []*submitter.AggregateAttestation
+	attestationSummaries      []*submitter.AttestationSummary
+	reorgs                    []*submitter.Reorg
+	finalityDelays            []*submitter.FinalityDelay
+	blobDelaySummaries        []*submitter.BlobDelaySummary
+	dataColumnSummaries       []*submitter.DataColumnSummary
+	syncCommitteeSummaries    []*submitter.SyncCommitteeSummary
+	operationSummaries        []*submitter.OperationSummary
+	payloadAttributesDelays   []*submitter.PayloadAttributesDelay
+	blockPropagationSummaries []*submitter.BlockPropagationSummary
+	slotOutcomes              []*submitter.SlotOutcome
+}
+
+// NewRecorder creates a new recording mock submitter.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// SubmitBlockDelay records a block delay data point.
+func (r *Recorder) SubmitBlockDelay(_ context.Context, data *submitter.BlockDelay) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blockDelays = append(r.blockDelays, data)
+}
+
+// BlockDelays returns the block delay data points submitted so far.
+func (r *Recorder) BlockDelays() []*submitter.BlockDelay {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.BlockDelay{}, r.blockDelays...)
+}
+
+// SubmitHeadDelay records a head delay data point.
+func (r *Recorder) SubmitHeadDelay(_ context.Context, data *submitter.HeadDelay) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.headDelays = append(r.headDelays, data)
+}
+
+// HeadDelays returns the head delay data points submitted so far.
+func (r *Recorder) HeadDelays() []*submitter.HeadDelay {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.HeadDelay{}, r.headDelays...)
+}
+
+// SubmitAggregateAttestation records an aggregate attestation data point.
+func (r *Recorder) SubmitAggregateAttestation(_ context.Context, data *submitter.AggregateAttestation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.aggregateAttestations = append(r.aggregateAttestations, data)
+}
+
+// AggregateAttestations returns the aggregate attestation data points submitted so far.
+func (r *Recorder) AggregateAttestations() []*submitter.AggregateAttestation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.AggregateAttestation{}, r.aggregateAttestations...)
+}
+
+// SubmitAttestationSummary records an attestation summary.
+func (r *Recorder) SubmitAttestationSummary(_ context.Context, data *submitter.AttestationSummary) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.attestationSummaries = append(r.attestationSummaries, data)
+}
+
+// AttestationSummaries returns the attestation summaries submitted so far.
+func (r *Recorder) AttestationSummaries() []*submitter.AttestationSummary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.AttestationSummary{}, r.attestationSummaries...)
+}
+
+// SubmitReorg records a chain reorganisation data point.
+func (r *Recorder) SubmitReorg(_ context.Context, data *submitter.Reorg) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reorgs = append(r.reorgs, data)
+}
+
+// Reorgs returns the chain reorganisation data points submitted so far.
+func (r *Recorder) Reorgs() []*submitter.Reorg {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.Reorg{}, r.reorgs...)
+}
+
+// SubmitFinalityDelay records a finality delay data point.
+func (r *Recorder) SubmitFinalityDelay(_ context.Context, data *submitter.FinalityDelay) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.finalityDelays = append(r.finalityDelays, data)
+}
+
+// FinalityDelays returns the finality delay data points submitted so far.
+func (r *Recorder) FinalityDelays() []*submitter.FinalityDelay {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.FinalityDelay{}, r.finalityDelays...)
+}
+
+// SubmitBlobDelaySummary records a blob delay summary.
+func (r *Recorder) SubmitBlobDelaySummary(_ context.Context, data *submitter.BlobDelaySummary) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blobDelaySummaries = append(r.blobDelaySummaries, data)
+}
+
+// BlobDelaySummaries returns the blob delay summaries submitted so far.
+func (r *Recorder) BlobDelaySummaries() []*submitter.BlobDelaySummary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.BlobDelaySummary{}, r.blobDelaySummaries...)
+}
+
+// SubmitDataColumnSummary records a data column summary.
+func (r *Recorder) SubmitDataColumnSummary(_ context.Context, data *submitter.DataColumnSummary) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dataColumnSummaries = append(r.dataColumnSummaries, data)
+}
+
+// DataColumnSummaries returns the data column summaries submitted so far.
+func (r *Recorder) DataColumnSummaries() []*submitter.DataColumnSummary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.DataColumnSummary{}, r.dataColumnSummaries...)
+}
+
+// SubmitSyncCommitteeSummary records a sync committee summary.
+func (r *Recorder) SubmitSyncCommitteeSummary(_ context.Context, data *submitter.SyncCommitteeSummary) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.syncCommitteeSummaries = append(r.syncCommitteeSummaries, data)
+}
+
+// SyncCommitteeSummaries returns the sync committee summaries submitted so far.
+func (r *Recorder) SyncCommitteeSummaries() []*submitter.SyncCommitteeSummary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.SyncCommitteeSummary{}, r.syncCommitteeSummaries...)
+}
+
+// SubmitOperationSummary records an operation summary.
+func (r *Recorder) SubmitOperationSummary(_ context.Context, data *submitter.OperationSummary) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.operationSummaries = append(r.operationSummaries, data)
+}
+
+// OperationSummaries returns the operation summaries submitted so far.
+func (r *Recorder) OperationSummaries() []*submitter.OperationSummary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.OperationSummary{}, r.operationSummaries...)
+}
+
+// SubmitPayloadAttributesDelay records a payload attributes delay data point.
+func (r *Recorder) SubmitPayloadAttributesDelay(_ context.Context, data *submitter.PayloadAttributesDelay) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.payloadAttributesDelays = append(r.payloadAttributesDelays, data)
+}
+
+// PayloadAttributesDelays returns the payload attributes delay data points submitted so far.
+func (r *Recorder) PayloadAttributesDelays() []*submitter.PayloadAttributesDelay {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.PayloadAttributesDelay{}, r.payloadAttributesDelays...)
+}
+
+// SubmitBlockPropagationSummary records a block propagation summary.
+func (r *Recorder) SubmitBlockPropagationSummary(_ context.Context, data *submitter.BlockPropagationSummary) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blockPropagationSummaries = append(r.blockPropagationSummaries, data)
+}
+
+// BlockPropagationSummaries returns the block propagation summaries submitted so far.
+func (r *Recorder) BlockPropagationSummaries() []*submitter.BlockPropagationSummary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.BlockPropagationSummary{}, r.blockPropagationSummaries...)
+}
+
+// SubmitSlotOutcome records a slot outcome data point.
+func (r *Recorder) SubmitSlotOutcome(_ context.Context, data *submitter.SlotOutcome) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.slotOutcomes = append(r.slotOutcomes, data)
+}
+
+// SlotOutcomes returns the slot outcome data points submitted so far.
+func (r *Recorder) SlotOutcomes() []*submitter.SlotOutcome {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*submitter.SlotOutcome{}, r.slotOutcomes...)
+}
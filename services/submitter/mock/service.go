@@ -1,4 +1,4 @@
-// Copyright © 2022 Weald Technology Trading.
+// Copyright © 2022, 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -28,13 +28,43 @@ func New() submitter.Service {
 }
 
 // SubmitBlockDelay submits a block delay data point.
-func (*service) SubmitBlockDelay(_ context.Context, _ string) {}
+func (*service) SubmitBlockDelay(_ context.Context, _ *submitter.BlockDelay) {}
 
 // SubmitHeadDelay submits a head delay data point.
-func (*service) SubmitHeadDelay(_ context.Context, _ string) {}
+func (*service) SubmitHeadDelay(_ context.Context, _ *submitter.HeadDelay) {}
 
 // SubmitAggregateAttestation submits an aggregate attestation data point.
-func (*service) SubmitAggregateAttestation(_ context.Context, _ string) {}
+func (*service) SubmitAggregateAttestation(_ context.Context, _ *submitter.AggregateAttestation) {}
 
 // SubmitAttestationSummary submits a summary of attestation data points.
-func (*service) SubmitAttestationSummary(_ context.Context, _ string) {}
+func (*service) SubmitAttestationSummary(_ context.Context, _ *submitter.AttestationSummary) {}
+
+// SubmitReorg submits a chain reorganisation data point.
+func (*service) SubmitReorg(_ context.Context, _ *submitter.Reorg) {}
+
+// SubmitFinalityDelay submits a finality delay data point.
+func (*service) SubmitFinalityDelay(_ context.Context, _ *submitter.FinalityDelay) {}
+
+// SubmitBlobDelaySummary submits a summary of blob sidecar delays.
+func (*service) SubmitBlobDelaySummary(_ context.Context, _ *submitter.BlobDelaySummary) {}
+
+// SubmitDataColumnSummary submits a summary of data column sidecar delays.
+func (*service) SubmitDataColumnSummary(_ context.Context, _ *submitter.DataColumnSummary) {}
+
+// SubmitSyncCommitteeSummary submits a summary of sync committee contributions.
+func (*service) SubmitSyncCommitteeSummary(_ context.Context, _ *submitter.SyncCommitteeSummary) {}
+
+// SubmitOperationSummary submits a summary of the sightings of a pool operation.
+func (*service) SubmitOperationSummary(_ context.Context, _ *submitter.OperationSummary) {}
+
+// SubmitPayloadAttributesDelay submits a payload attributes delay data point.
+func (*service) SubmitPayloadAttributesDelay(_ context.Context, _ *submitter.PayloadAttributesDelay) {
+}
+
+// SubmitBlockPropagationSummary submits a summary of the propagation of a block across nodes.
+func (*service) SubmitBlockPropagationSummary(_ context.Context, _ *submitter.BlockPropagationSummary) {
+}
+
+// SubmitSlotOutcome submits a slot outcome data point.
+func (*service) SubmitSlotOutcome(_ context.Context, _ *submitter.SlotOutcome) {
+}
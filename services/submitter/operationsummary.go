@@ -0,0 +1,136 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// OperationSummary is a summary of the sightings of a pool operation, such as
+// a slashing or voluntary exit, across nodes.  Sightings are keyed by source.
+type OperationSummary struct {
+	Method    string
+	Type      string
+	Root      phase0.Root
+	FirstSeen time.Time
+	Sightings map[string]*OperationSighting
+}
+
+// operationSummaryJSON is the wire representation of the struct.
+type operationSummaryJSON struct {
+	Method    string                        `json:"method"`
+	Type      string                        `json:"type"`
+	Root      phase0.Root                   `json:"root"`
+	FirstSeen string                        `json:"first_seen_ms"`
+	Sightings map[string]*OperationSighting `json:"sightings"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *OperationSummary) MarshalJSON() ([]byte, error) {
+	sightings := o.Sightings
+	if sightings == nil {
+		sightings = make(map[string]*OperationSighting)
+	}
+
+	return json.Marshal(&operationSummaryJSON{
+		Method:    o.Method,
+		Type:      o.Type,
+		Root:      o.Root,
+		FirstSeen: fmt.Sprintf("%d", o.FirstSeen.UnixMilli()),
+		Sightings: sightings,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OperationSummary) UnmarshalJSON(input []byte) error {
+	var data operationSummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	o.Method = data.Method
+	if data.Type == "" {
+		return errors.New("type missing")
+	}
+	o.Type = data.Type
+	o.Root = data.Root
+	if data.FirstSeen == "" {
+		return errors.New("first seen missing")
+	}
+	firstSeen, err := strconv.ParseInt(data.FirstSeen, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for first seen")
+	}
+	o.FirstSeen = time.UnixMilli(firstSeen)
+	if data.Sightings == nil {
+		return errors.New("sightings missing")
+	}
+	o.Sightings = data.Sightings
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (o *OperationSummary) String() string {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// OperationSighting is the sighting of a pool operation by a single node.
+type OperationSighting struct {
+	// SinceFirst is the time from the operation first being seen by any node
+	// to it being seen by this node.
+	SinceFirst time.Duration
+}
+
+// operationSightingJSON is the wire representation of the struct.
+type operationSightingJSON struct {
+	SinceFirstMS string `json:"since_first_ms"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *OperationSighting) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&operationSightingJSON{
+		SinceFirstMS: fmt.Sprintf("%d", o.SinceFirst.Milliseconds()),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (o *OperationSighting) UnmarshalJSON(input []byte) error {
+	var data operationSightingJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.SinceFirstMS == "" {
+		return errors.New("since first missing")
+	}
+	sinceFirst, err := strconv.ParseInt(data.SinceFirstMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for since first")
+	}
+	o.SinceFirst = time.Duration(sinceFirst) * time.Millisecond
+
+	return nil
+}
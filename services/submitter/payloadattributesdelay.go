@@ -0,0 +1,128 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// PayloadAttributesDelay is a payload attributes delay data point.
+type PayloadAttributesDelay struct {
+	Source          string
+	Method          string
+	ProposalSlot    phase0.Slot
+	ProposerIndex   phase0.ValidatorIndex
+	ParentBlockRoot phase0.Root
+	// Events is the number of payload attributes events seen for the slot
+	// with this parent block root.
+	Events uint64
+	// Delay is the time from the start of the proposal slot to receipt of
+	// the first payload attributes event with this parent block root; it is
+	// usually negative.
+	Delay time.Duration
+	// ParentMatchesHead is true if the parent block root matches the
+	// head of the chain prior to the proposal slot.
+	ParentMatchesHead bool
+}
+
+// payloadAttributesDelayJSON is the wire representation of the struct.
+type payloadAttributesDelayJSON struct {
+	Source            string      `json:"source"`
+	Method            string      `json:"method"`
+	ProposalSlot      string      `json:"proposal_slot"`
+	ProposerIndex     string      `json:"proposer_index"`
+	ParentBlockRoot   phase0.Root `json:"parent_block_root"`
+	Events            string      `json:"events"`
+	DelayMS           string      `json:"delay_ms"`
+	ParentMatchesHead bool        `json:"parent_matches_head"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p *PayloadAttributesDelay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&payloadAttributesDelayJSON{
+		Source:            p.Source,
+		Method:            p.Method,
+		ProposalSlot:      fmt.Sprintf("%d", p.ProposalSlot),
+		ProposerIndex:     fmt.Sprintf("%d", p.ProposerIndex),
+		ParentBlockRoot:   p.ParentBlockRoot,
+		Events:            strconv.FormatUint(p.Events, 10),
+		DelayMS:           fmt.Sprintf("%d", p.Delay.Milliseconds()),
+		ParentMatchesHead: p.ParentMatchesHead,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PayloadAttributesDelay) UnmarshalJSON(input []byte) error {
+	var data payloadAttributesDelayJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Source == "" {
+		return errors.New("source missing")
+	}
+	p.Source = data.Source
+	p.Method = data.Method
+	if data.ProposalSlot == "" {
+		return errors.New("proposal slot missing")
+	}
+	proposalSlot, err := strconv.ParseUint(data.ProposalSlot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for proposal slot")
+	}
+	p.ProposalSlot = phase0.Slot(proposalSlot)
+	if data.ProposerIndex == "" {
+		return errors.New("proposer index missing")
+	}
+	proposerIndex, err := strconv.ParseUint(data.ProposerIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for proposer index")
+	}
+	p.ProposerIndex = phase0.ValidatorIndex(proposerIndex)
+	p.ParentBlockRoot = data.ParentBlockRoot
+	if data.Events == "" {
+		return errors.New("events missing")
+	}
+	p.Events, err = strconv.ParseUint(data.Events, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for events")
+	}
+	if data.DelayMS == "" {
+		return errors.New("delay missing")
+	}
+	delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for delay")
+	}
+	p.Delay = time.Duration(delay) * time.Millisecond
+	p.ParentMatchesHead = data.ParentMatchesHead
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (p *PayloadAttributesDelay) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
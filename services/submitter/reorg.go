@@ -0,0 +1,129 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Reorg is a chain reorganisation data point.
+type Reorg struct {
+	Source       string
+	Method       string
+	Slot         phase0.Slot
+	Depth        uint64
+	OldHeadBlock phase0.Root
+	NewHeadBlock phase0.Root
+	OldHeadState phase0.Root
+	NewHeadState phase0.Root
+	Epoch        phase0.Epoch
+	Delay        time.Duration
+}
+
+// reorgJSON is the wire representation of the struct.
+type reorgJSON struct {
+	Source       string      `json:"source"`
+	Method       string      `json:"method"`
+	Slot         string      `json:"slot"`
+	Depth        string      `json:"depth"`
+	OldHeadBlock phase0.Root `json:"old_head_block"`
+	NewHeadBlock phase0.Root `json:"new_head_block"`
+	OldHeadState phase0.Root `json:"old_head_state"`
+	NewHeadState phase0.Root `json:"new_head_state"`
+	Epoch        string      `json:"epoch"`
+	DelayMS      string      `json:"delay_ms"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *Reorg) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&reorgJSON{
+		Source:       r.Source,
+		Method:       r.Method,
+		Slot:         fmt.Sprintf("%d", r.Slot),
+		Depth:        strconv.FormatUint(r.Depth, 10),
+		OldHeadBlock: r.OldHeadBlock,
+		NewHeadBlock: r.NewHeadBlock,
+		OldHeadState: r.OldHeadState,
+		NewHeadState: r.NewHeadState,
+		Epoch:        fmt.Sprintf("%d", r.Epoch),
+		DelayMS:      fmt.Sprintf("%d", r.Delay.Milliseconds()),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Reorg) UnmarshalJSON(input []byte) error {
+	var data reorgJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Source == "" {
+		return errors.New("source missing")
+	}
+	r.Source = data.Source
+	r.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	r.Slot = phase0.Slot(slot)
+	if data.Depth == "" {
+		return errors.New("depth missing")
+	}
+	r.Depth, err = strconv.ParseUint(data.Depth, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for depth")
+	}
+	r.OldHeadBlock = data.OldHeadBlock
+	r.NewHeadBlock = data.NewHeadBlock
+	r.OldHeadState = data.OldHeadState
+	r.NewHeadState = data.NewHeadState
+	if data.Epoch == "" {
+		return errors.New("epoch missing")
+	}
+	epoch, err := strconv.ParseUint(data.Epoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for epoch")
+	}
+	r.Epoch = phase0.Epoch(epoch)
+	if data.DelayMS == "" {
+		return errors.New("delay missing")
+	}
+	delay, err := strconv.ParseInt(data.DelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for delay")
+	}
+	r.Delay = time.Duration(delay) * time.Millisecond
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (r *Reorg) String() string {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022, 2023 Weald Technology Trading.
+// Copyright © 2022 - 2026 Weald Technology Trading.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -20,14 +20,41 @@ import (
 // Service is a submitter service.
 type Service interface {
 	// SubmitBlockDelay submits a block delay data point.
-	SubmitBlockDelay(ctx context.Context, body string)
+	SubmitBlockDelay(ctx context.Context, data *BlockDelay)
 
 	// SubmitHeadDelay submits a head delay data point.
-	SubmitHeadDelay(ctx context.Context, body string)
+	SubmitHeadDelay(ctx context.Context, data *HeadDelay)
 
 	// SubmitAggregateAttestation submits an aggregate attestation data point.
-	SubmitAggregateAttestation(ctx context.Context, body string)
+	SubmitAggregateAttestation(ctx context.Context, data *AggregateAttestation)
 
 	// SubmitAttestationSummary submits a summary of attestation data points.
-	SubmitAttestationSummary(ctx context.Context, body string)
+	SubmitAttestationSummary(ctx context.Context, data *AttestationSummary)
+
+	// SubmitReorg submits a chain reorganisation data point.
+	SubmitReorg(ctx context.Context, data *Reorg)
+
+	// SubmitFinalityDelay submits a finality delay data point.
+	SubmitFinalityDelay(ctx context.Context, data *FinalityDelay)
+
+	// SubmitBlobDelaySummary submits a summary of blob sidecar delays.
+	SubmitBlobDelaySummary(ctx context.Context, data *BlobDelaySummary)
+
+	// SubmitDataColumnSummary submits a summary of data column sidecar delays.
+	SubmitDataColumnSummary(ctx context.Context, data *DataColumnSummary)
+
+	// SubmitSyncCommitteeSummary submits a summary of sync committee contributions.
+	SubmitSyncCommitteeSummary(ctx context.Context, data *SyncCommitteeSummary)
+
+	// SubmitOperationSummary submits a summary of the sightings of a pool operation.
+	SubmitOperationSummary(ctx context.Context, data *OperationSummary)
+
+	// SubmitPayloadAttributesDelay submits a payload attributes delay data point.
+	SubmitPayloadAttributesDelay(ctx context.Context, data *PayloadAttributesDelay)
+
+	// SubmitBlockPropagationSummary submits a summary of the propagation of a block across nodes.
+	SubmitBlockPropagationSummary(ctx context.Context, data *BlockPropagationSummary)
+
+	// SubmitSlotOutcome submits a slot outcome data point.
+	SubmitSlotOutcome(ctx context.Context, data *SlotOutcome)
 }
@@ -0,0 +1,122 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Outcomes of a slot that did not result in a canonical block.
+const (
+	// SlotOutcomeMissed is a slot for which no node saw a block.
+	SlotOutcomeMissed = "missed"
+	// SlotOutcomeOrphaned is a slot for which a block was seen but did not become the head.
+	SlotOutcomeOrphaned = "orphaned"
+)
+
+// SlotOutcome is a data point for a slot that did not result in a canonical block.
+type SlotOutcome struct {
+	Method  string
+	Slot    phase0.Slot
+	Outcome string
+	// BlockRoot is the root of the orphaned block; it is zero for a missed slot.
+	BlockRoot phase0.Root
+	// Seen are the nodes that saw the block.
+	Seen []string
+	// Missing are the nodes that did not see the block.
+	Missing []string
+}
+
+// slotOutcomeJSON is the wire representation of the struct.
+type slotOutcomeJSON struct {
+	Method    string      `json:"method"`
+	Slot      string      `json:"slot"`
+	Outcome   string      `json:"outcome"`
+	BlockRoot phase0.Root `json:"block_root"`
+	Seen      []string    `json:"seen"`
+	Missing   []string    `json:"missing"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SlotOutcome) MarshalJSON() ([]byte, error) {
+	seen := s.Seen
+	if seen == nil {
+		seen = make([]string, 0)
+	}
+	missing := s.Missing
+	if missing == nil {
+		missing = make([]string, 0)
+	}
+
+	return json.Marshal(&slotOutcomeJSON{
+		Method:    s.Method,
+		Slot:      fmt.Sprintf("%d", s.Slot),
+		Outcome:   s.Outcome,
+		BlockRoot: s.BlockRoot,
+		Seen:      seen,
+		Missing:   missing,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SlotOutcome) UnmarshalJSON(input []byte) error {
+	var data slotOutcomeJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	s.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	s.Slot = phase0.Slot(slot)
+	switch data.Outcome {
+	case "":
+		return errors.New("outcome missing")
+	case SlotOutcomeMissed, SlotOutcomeOrphaned:
+		s.Outcome = data.Outcome
+	default:
+		return errors.New("invalid value for outcome")
+	}
+	s.BlockRoot = data.BlockRoot
+	if data.Seen == nil {
+		return errors.New("seen missing")
+	}
+	s.Seen = data.Seen
+	if data.Missing == nil {
+		return errors.New("missing nodes missing")
+	}
+	s.Missing = data.Missing
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (s *SlotOutcome) String() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,206 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submitter
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// SyncCommitteeSummary is a summary of the sync committee contributions seen for a slot.
+type SyncCommitteeSummary struct {
+	Method        string
+	Slot          phase0.Slot
+	Contributions []*SyncCommitteeContributionSummary
+}
+
+// syncCommitteeSummaryJSON is the wire representation of the struct.
+type syncCommitteeSummaryJSON struct {
+	Method        string                              `json:"method"`
+	Slot          string                              `json:"slot"`
+	Contributions []*SyncCommitteeContributionSummary `json:"contributions"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SyncCommitteeSummary) MarshalJSON() ([]byte, error) {
+	contributions := s.Contributions
+	if contributions == nil {
+		contributions = make([]*SyncCommitteeContributionSummary, 0)
+	}
+
+	return json.Marshal(&syncCommitteeSummaryJSON{
+		Method:        s.Method,
+		Slot:          fmt.Sprintf("%d", s.Slot),
+		Contributions: contributions,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SyncCommitteeSummary) UnmarshalJSON(input []byte) error {
+	var data syncCommitteeSummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	s.Method = data.Method
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+	s.Slot = phase0.Slot(slot)
+	if data.Contributions == nil {
+		return errors.New("contributions missing")
+	}
+	s.Contributions = data.Contributions
+
+	return nil
+}
+
+// String returns a string version of the structure.
+func (s *SyncCommitteeSummary) String() string {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// SyncCommitteeContributionSummary is a summary of the contributions seen for a
+// single subcommittee and beacon block root.  Nodes are keyed by source.
+type SyncCommitteeContributionSummary struct {
+	SubcommitteeIndex uint64
+	BeaconBlockRoot   phase0.Root
+	Nodes             map[string]*SyncCommitteeNodeSummary
+}
+
+// syncCommitteeContributionSummaryJSON is the wire representation of the struct.
+type syncCommitteeContributionSummaryJSON struct {
+	SubcommitteeIndex string                               `json:"subcommittee_index"`
+	BeaconBlockRoot   phase0.Root                          `json:"beacon_block_root"`
+	Nodes             map[string]*SyncCommitteeNodeSummary `json:"nodes"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SyncCommitteeContributionSummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&syncCommitteeContributionSummaryJSON{
+		SubcommitteeIndex: strconv.FormatUint(s.SubcommitteeIndex, 10),
+		BeaconBlockRoot:   s.BeaconBlockRoot,
+		Nodes:             s.Nodes,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SyncCommitteeContributionSummary) UnmarshalJSON(input []byte) error {
+	var data syncCommitteeContributionSummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.SubcommitteeIndex == "" {
+		return errors.New("subcommittee index missing")
+	}
+	subcommitteeIndex, err := strconv.ParseUint(data.SubcommitteeIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for subcommittee index")
+	}
+	s.SubcommitteeIndex = subcommitteeIndex
+	s.BeaconBlockRoot = data.BeaconBlockRoot
+	if data.Nodes == nil {
+		return errors.New("nodes missing")
+	}
+	s.Nodes = data.Nodes
+
+	return nil
+}
+
+// SyncCommitteeNodeSummary is a summary of the contributions for a subcommittee seen by a single node.
+type SyncCommitteeNodeSummary struct {
+	// Contributions is the number of contributions seen.
+	Contributions uint64
+	// FirstDelay is the time from the start of the slot to receipt of the first contribution.
+	FirstDelay time.Duration
+	// AggregationBits is the union of the aggregation bits of the contributions.
+	AggregationBits bitfield.Bitvector128
+}
+
+// syncCommitteeNodeSummaryJSON is the wire representation of the struct.
+type syncCommitteeNodeSummaryJSON struct {
+	Contributions   string `json:"contributions"`
+	FirstDelayMS    string `json:"first_delay_ms"`
+	AggregationBits string `json:"aggregation_bits"`
+	Participation   string `json:"participation"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SyncCommitteeNodeSummary) MarshalJSON() ([]byte, error) {
+	var participation uint64
+	if s.AggregationBits != nil {
+		participation = s.AggregationBits.Count()
+	}
+
+	return json.Marshal(&syncCommitteeNodeSummaryJSON{
+		Contributions:   strconv.FormatUint(s.Contributions, 10),
+		FirstDelayMS:    fmt.Sprintf("%d", s.FirstDelay.Milliseconds()),
+		AggregationBits: fmt.Sprintf("%#x", []byte(s.AggregationBits)),
+		Participation:   strconv.FormatUint(participation, 10),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SyncCommitteeNodeSummary) UnmarshalJSON(input []byte) error {
+	var data syncCommitteeNodeSummaryJSON
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if data.Contributions == "" {
+		return errors.New("contributions missing")
+	}
+	contributions, err := strconv.ParseUint(data.Contributions, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for contributions")
+	}
+	s.Contributions = contributions
+	if data.FirstDelayMS == "" {
+		return errors.New("first delay missing")
+	}
+	firstDelay, err := strconv.ParseInt(data.FirstDelayMS, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for first delay")
+	}
+	s.FirstDelay = time.Duration(firstDelay) * time.Millisecond
+	if data.AggregationBits == "" {
+		return errors.New("aggregation bits missing")
+	}
+	aggregationBits, err := hex.DecodeString(strings.TrimPrefix(data.AggregationBits, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for aggregation bits")
+	}
+	s.AggregationBits = aggregationBits
+	// Participation is derived from the aggregation bits, so is not read.
+
+	return nil
+}
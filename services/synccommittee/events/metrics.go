@@ -0,0 +1,160 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/wealdtech/probec/services/metrics"
+)
+
+var (
+	delayTimer             prometheus.Histogram
+	participationHistogram prometheus.Histogram
+	latestTimestamp        prometheus.Gauge
+	eventsReceived         prometheus.Counter
+	eventsIgnored          prometheus.Counter
+	summarySlots           prometheus.Gauge
+	slotsEvicted           prometheus.Counter
+)
+
+func registerMetrics(ctx context.Context, monitor metrics.Service) error {
+	if latestTimestamp != nil {
+		// Already registered.
+		return nil
+	}
+	if monitor == nil {
+		// No monitor.
+		return nil
+	}
+	if monitor.Presenter() == "prometheus" {
+		return registerPrometheusMetrics(ctx)
+	}
+
+	return nil
+}
+
+func registerPrometheusMetrics(_ context.Context) error {
+	delayTimer = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "synccommittee",
+		Name:      "delay_seconds",
+		Help:      "The time from the start of the slot to receipt of the contribution and proof event.",
+		Buckets:   prometheus.LinearBuckets(0.1, 0.1, 120),
+	})
+	if err := prometheus.Register(delayTimer); err != nil {
+		return err
+	}
+
+	participationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "probec",
+		Subsystem: "synccommittee",
+		Name:      "participation",
+		Help:      "The number of participants in received sync committee contributions.",
+		Buckets:   prometheus.LinearBuckets(8, 8, 16),
+	})
+	if err := prometheus.Register(participationHistogram); err != nil {
+		return err
+	}
+
+	latestTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "synccommittee",
+		Name:      "latest_timestamp",
+		Help:      "The latest timestamp at which probec obtained a contribution and proof event.",
+	})
+	if err := prometheus.Register(latestTimestamp); err != nil {
+		return err
+	}
+
+	eventsReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "synccommittee",
+		Name:      "events_total",
+		Help:      "The number of contribution and proof events received.",
+	})
+	if err := prometheus.Register(eventsReceived); err != nil {
+		return err
+	}
+
+	eventsIgnored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "synccommittee",
+		Name:      "events_ignored_total",
+		Help:      "The number of contribution and proof events ignored because their node was not in a submittable state.",
+	})
+	if err := prometheus.Register(eventsIgnored); err != nil {
+		return err
+	}
+
+	summarySlots = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "probec",
+		Subsystem: "synccommittee",
+		Name:      "summary_slots",
+		Help:      "The number of slots for which sync committee summaries are held.",
+	})
+	if err := prometheus.Register(summarySlots); err != nil {
+		return err
+	}
+
+	slotsEvicted = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "probec",
+		Subsystem: "synccommittee",
+		Name:      "slots_evicted_total",
+		Help:      "The number of slots for which sync committee summaries were evicted without being submitted.",
+	})
+
+	return prometheus.Register(slotsEvicted)
+}
+
+// monitorEventProcessed is called when a contribution and proof event has been processed.
+func monitorEventProcessed(delay time.Duration, participation uint64) {
+	if latestTimestamp == nil {
+		return
+	}
+
+	latestTimestamp.SetToCurrentTime()
+	eventsReceived.Inc()
+	delayTimer.Observe(delay.Seconds())
+	participationHistogram.Observe(float64(participation))
+}
+
+// monitorEventIgnored is called when a contribution and proof event has been ignored.
+func monitorEventIgnored() {
+	if eventsIgnored == nil {
+		return
+	}
+
+	eventsIgnored.Inc()
+}
+
+// monitorSummarySlots is called when the number of slots for which summaries are held changes.
+func monitorSummarySlots(slots int) {
+	if summarySlots == nil {
+		return
+	}
+
+	summarySlots.Set(float64(slots))
+}
+
+// monitorSlotsEvicted is called when summaries for stale slots have been evicted.
+func monitorSlotsEvicted(slots int) {
+	if slotsEvicted == nil {
+		return
+	}
+
+	slotsEvicted.Add(float64(slots))
+}
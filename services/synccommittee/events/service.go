@@ -0,0 +1,230 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	consensusclient "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/nodestatus"
+	"github.com/wealdtech/probec/services/streams"
+	"github.com/wealdtech/probec/services/submitter"
+)
+
+// contributionSummary provides a summary of contributions for a given subcommittee and beacon block root.
+type contributionSummary struct {
+	subcommitteeIndex uint64
+	beaconBlockRoot   phase0.Root
+	nodes             map[string]*submitter.SyncCommitteeNodeSummary
+}
+
+// Service is a sync committee contribution tracker service.
+type Service struct {
+	chainTime             chaintime.Service
+	submitter             submitter.Service
+	streams               streams.Service
+	nodeStatus            nodestatus.Service
+	flushOffset           time.Duration
+	contributionsMu       sync.Mutex
+	contributionSummaries map[phase0.Slot]map[string]*contributionSummary
+}
+
+// module-wide log.
+var log zerolog.Logger
+
+// New creates a new sync committee contribution tracker service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log = zerologger.With().Str("service", "synccommittee").Str("impl", "events").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	if err := registerMetrics(ctx, parameters.monitor); err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	s := &Service{
+		chainTime:             parameters.chainTime,
+		submitter:             parameters.submitter,
+		streams:               parameters.streams,
+		nodeStatus:            parameters.nodeStatus,
+		flushOffset:           parameters.flushOffset,
+		contributionSummaries: make(map[phase0.Slot]map[string]*contributionSummary),
+	}
+
+	for address, eventsProvider := range parameters.eventsProviders {
+		if err := s.monitorEvents(ctx, address, eventsProvider); err != nil {
+			return nil, err
+		}
+	}
+
+	go s.flushSummaries(ctx)
+
+	return s, nil
+}
+
+func (s *Service) monitorEvents(ctx context.Context,
+	address string,
+	eventsProvider consensusclient.EventsProvider,
+) error {
+	if err := s.streams.Subscribe(ctx, address, eventsProvider, &api.EventsOpts{
+		Topics: []string{"contribution_and_proof"},
+		ContributionAndProofHandler: func(_ context.Context, event *altair.SignedContributionAndProof) {
+			if event.Message == nil || event.Message.Contribution == nil {
+				log.Debug().Msg("Contribution and proof event without contribution; ignoring")
+				return
+			}
+			contribution := event.Message.Contribution
+
+			delay := time.Since(s.chainTime.StartOfSlot(contribution.Slot))
+			if delay < 0 || delay > s.chainTime.SlotDuration() {
+				log.Trace().Uint64("slot", uint64(contribution.Slot)).Stringer("delay", delay).Msg("Delay out of range, ignoring")
+				return
+			}
+
+			// Ensure the node is in a state to provide useful information.
+			if !s.nodeStatus.Submittable(address) {
+				log.Debug().Str("address", address).Msg("Node is not in a submittable state, not sending information")
+				monitorEventIgnored()
+				return
+			}
+
+			monitorEventProcessed(delay, contribution.AggregationBits.Count())
+
+			s.handleContribution(address, contribution, delay)
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create events provider")
+	}
+
+	return nil
+}
+
+func (s *Service) handleContribution(address string,
+	contribution *altair.SyncCommitteeContribution,
+	delay time.Duration,
+) {
+	key := fmt.Sprintf("%d:%x", contribution.SubcommitteeIndex, contribution.BeaconBlockRoot)
+	s.contributionsMu.Lock()
+	slotSummaries, exists := s.contributionSummaries[contribution.Slot]
+	if !exists {
+		slotSummaries = make(map[string]*contributionSummary)
+		s.contributionSummaries[contribution.Slot] = slotSummaries
+	}
+	summary, exists := slotSummaries[key]
+	if !exists {
+		summary = &contributionSummary{
+			subcommitteeIndex: contribution.SubcommitteeIndex,
+			beaconBlockRoot:   contribution.BeaconBlockRoot,
+			nodes:             make(map[string]*submitter.SyncCommitteeNodeSummary),
+		}
+		slotSummaries[key] = summary
+	}
+	node, exists := summary.nodes[address]
+	if !exists {
+		node = &submitter.SyncCommitteeNodeSummary{
+			FirstDelay: delay,
+		}
+		summary.nodes[address] = node
+	}
+	node.Contributions++
+	if node.AggregationBits == nil {
+		node.AggregationBits = contribution.AggregationBits
+	} else {
+		aggregationBits, err := node.AggregationBits.Or(contribution.AggregationBits)
+		if err != nil {
+			s.contributionsMu.Unlock()
+			log.Error().Err(err).Msg("Failed to aggregate contributions")
+			return
+		}
+		node.AggregationBits = aggregationBits
+	}
+	monitorSummarySlots(len(s.contributionSummaries))
+	s.contributionsMu.Unlock()
+}
+
+// flushSummaries submits the summary for each slot once the flush offset
+// after the end of the slot has passed.
+func (s *Service) flushSummaries(ctx context.Context) {
+	for {
+		slot := s.chainTime.TimestampToSlot(time.Now().Add(-s.flushOffset))
+		timer := time.NewTimer(time.Until(s.chainTime.StartOfSlot(slot + 1).Add(s.flushOffset)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.flushSlot(ctx, slot)
+	}
+}
+
+// flushSlot submits and removes the summary for the given slot, and evicts
+// any summaries for earlier slots.
+func (s *Service) flushSlot(ctx context.Context, slot phase0.Slot) {
+	s.contributionsMu.Lock()
+	slotSummaries, exists := s.contributionSummaries[slot]
+	delete(s.contributionSummaries, slot)
+	evicted := 0
+	for summarySlot := range s.contributionSummaries {
+		if summarySlot < slot {
+			delete(s.contributionSummaries, summarySlot)
+			evicted++
+		}
+	}
+	monitorSummarySlots(len(s.contributionSummaries))
+	s.contributionsMu.Unlock()
+
+	if evicted > 0 {
+		log.Debug().Uint64("slot", uint64(slot)).Int("evicted", evicted).Msg("Evicted stale sync committee summaries")
+		monitorSlotsEvicted(evicted)
+	}
+	if !exists {
+		return
+	}
+
+	// Build and send the data.
+	data := &submitter.SyncCommitteeSummary{
+		Method:        "contribution and proof event",
+		Slot:          slot,
+		Contributions: make([]*submitter.SyncCommitteeContributionSummary, 0, len(slotSummaries)),
+	}
+	for _, summary := range slotSummaries {
+		data.Contributions = append(data.Contributions, &submitter.SyncCommitteeContributionSummary{
+			SubcommitteeIndex: summary.subcommitteeIndex,
+			BeaconBlockRoot:   summary.beaconBlockRoot,
+			Nodes:             summary.nodes,
+		})
+	}
+	log.Trace().Stringer("data", data).Msg("Sync committee summary")
+
+	s.submitter.SubmitSyncCommitteeSummary(ctx, data)
+}
@@ -0,0 +1,133 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+	mockchaintime "github.com/wealdtech/probec/services/chaintime/mock"
+	mocknodestatus "github.com/wealdtech/probec/services/nodestatus/mock"
+	mocksubmitter "github.com/wealdtech/probec/services/submitter/mock"
+)
+
+func newTestService(t *testing.T) (*Service, *mocksubmitter.Recorder) {
+	t.Helper()
+
+	chainTime, err := mockchaintime.NewStandard(time.Now(), 12*time.Second)
+	require.NoError(t, err)
+
+	recorder := mocksubmitter.NewRecorder()
+
+	return &Service{
+		chainTime:             chainTime,
+		submitter:             recorder,
+		nodeStatus:            mocknodestatus.New(),
+		flushOffset:           time.Second,
+		contributionSummaries: make(map[phase0.Slot]map[string]*contributionSummary),
+	}, recorder
+}
+
+func contribution(slot phase0.Slot, subcommitteeIndex uint64, root phase0.Root, bits ...uint64) *altair.SyncCommitteeContribution {
+	aggregationBits := bitfield.NewBitvector128()
+	for _, bit := range bits {
+		aggregationBits.SetBitAt(bit, true)
+	}
+
+	return &altair.SyncCommitteeContribution{
+		Slot:              slot,
+		BeaconBlockRoot:   root,
+		SubcommitteeIndex: subcommitteeIndex,
+		AggregationBits:   aggregationBits,
+	}
+}
+
+func TestParticipation(t *testing.T) {
+	ctx := context.Background()
+	s, recorder := newTestService(t)
+
+	root := phase0.Root{0x01}
+
+	// Node a sees two overlapping contributions for subcommittee 0, node b one of them.
+	s.handleContribution("a", contribution(10, 0, root, 1, 2), 8*time.Second)
+	s.handleContribution("a", contribution(10, 0, root, 2, 3), 9*time.Second)
+	s.handleContribution("b", contribution(10, 0, root, 2, 3), 10*time.Second)
+
+	// Node a sees a contribution for subcommittee 1.
+	s.handleContribution("a", contribution(10, 1, root, 5), 8*time.Second)
+
+	s.flushSlot(ctx, 10)
+	require.Len(t, recorder.SyncCommitteeSummaries(), 1)
+	summary := recorder.SyncCommitteeSummaries()[0]
+	require.Equal(t, phase0.Slot(10), summary.Slot)
+	require.Len(t, summary.Contributions, 2)
+
+	for _, summaryContribution := range summary.Contributions {
+		require.Equal(t, root, summaryContribution.BeaconBlockRoot)
+		switch summaryContribution.SubcommitteeIndex {
+		case 0:
+			require.Len(t, summaryContribution.Nodes, 2)
+			require.Equal(t, uint64(2), summaryContribution.Nodes["a"].Contributions)
+			require.Equal(t, 8*time.Second, summaryContribution.Nodes["a"].FirstDelay)
+			require.Equal(t, uint64(3), summaryContribution.Nodes["a"].AggregationBits.Count())
+			require.True(t, summaryContribution.Nodes["a"].AggregationBits.BitAt(1))
+			require.True(t, summaryContribution.Nodes["a"].AggregationBits.BitAt(3))
+			require.Equal(t, uint64(1), summaryContribution.Nodes["b"].Contributions)
+			require.Equal(t, 10*time.Second, summaryContribution.Nodes["b"].FirstDelay)
+			require.Equal(t, uint64(2), summaryContribution.Nodes["b"].AggregationBits.Count())
+		case 1:
+			require.Len(t, summaryContribution.Nodes, 1)
+			require.Equal(t, uint64(1), summaryContribution.Nodes["a"].AggregationBits.Count())
+		default:
+			require.Fail(t, "unexpected subcommittee index")
+		}
+	}
+}
+
+func TestFlushSlot(t *testing.T) {
+	ctx := context.Background()
+	s, recorder := newTestService(t)
+
+	root := phase0.Root{0x01}
+
+	// Slot without contributions submits nothing.
+	s.flushSlot(ctx, 10)
+	require.Empty(t, recorder.SyncCommitteeSummaries())
+
+	// Slots are flushed without the need for contributions in later slots.
+	s.handleContribution("a", contribution(11, 0, root, 1), time.Second)
+	s.flushSlot(ctx, 11)
+	require.Len(t, recorder.SyncCommitteeSummaries(), 1)
+	require.Equal(t, phase0.Slot(11), recorder.SyncCommitteeSummaries()[0].Slot)
+	require.Empty(t, s.contributionSummaries)
+
+	// Stale slots are evicted without being submitted, and later slots are retained.
+	s.handleContribution("a", contribution(12, 0, root, 1), time.Second)
+	s.handleContribution("a", contribution(13, 0, root, 1), time.Second)
+	s.handleContribution("a", contribution(15, 0, root, 1), time.Second)
+	s.flushSlot(ctx, 14)
+	require.Len(t, recorder.SyncCommitteeSummaries(), 1)
+	require.Len(t, s.contributionSummaries, 1)
+	require.Contains(t, s.contributionSummaries, phase0.Slot(15))
+
+	s.flushSlot(ctx, 15)
+	require.Len(t, recorder.SyncCommitteeSummaries(), 2)
+	require.Equal(t, phase0.Slot(15), recorder.SyncCommitteeSummaries()[1].Slot)
+	require.Empty(t, s.contributionSummaries)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/probec/signing"
+	"github.com/wealdtech/probec/util"
+)
+
+// defaultKeyFile is the default location of the signing key.
+const defaultKeyFile = "probec.key"
+
+// obtainSigner obtains the signer for submissions, if configured.
+func obtainSigner() (signing.Signer, error) {
+	if viper.GetString("signing.hmac-secret") != "" {
+		secret, err := util.ResolveSecret(viper.GetString("signing.hmac-secret"))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain HMAC secret")
+		}
+		signer, err := signing.NewHMACSigner(viper.GetString("signing.key-id"), []byte(secret))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create HMAC signer")
+		}
+
+		return signer, nil
+	}
+
+	keyFile := viper.GetString("signing.key-file")
+	if keyFile == "" {
+		// Use the key generated by keygen with its default location, if present.
+		if _, err := os.Stat(util.ResolvePath(defaultKeyFile)); err == nil {
+			log.Info().Str("key_file", util.ResolvePath(defaultKeyFile)).Msg("Using default signing key file")
+			keyFile = defaultKeyFile
+		}
+	}
+
+	if keyFile != "" {
+		data, err := os.ReadFile(util.ResolvePath(keyFile))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read signing key")
+		}
+		key, err := signing.ParsePrivateKey(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse signing key")
+		}
+		signer, err := signing.NewEd25519Signer(viper.GetString("signing.key-id"), key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create ed25519 signer")
+		}
+
+		return signer, nil
+	}
+
+	// No signing configured.
+	return nil, nil //nolint:nilnil
+}
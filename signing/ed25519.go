@@ -0,0 +1,117 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ed25519Signer signs with an ed25519 private key.
+type ed25519Signer struct {
+	keyID string
+	key   ed25519.PrivateKey
+}
+
+// NewEd25519Signer creates a signer using an ed25519 private key.
+// If the key ID is empty it is derived from the public key.
+func NewEd25519Signer(keyID string, key ed25519.PrivateKey) (Signer, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid ed25519 private key")
+	}
+	if keyID == "" {
+		publicKey, isPublicKey := key.Public().(ed25519.PublicKey)
+		if !isPublicKey {
+			return nil, errors.New("invalid ed25519 public key")
+		}
+		keyID = KeyID(publicKey)
+	}
+
+	return &ed25519Signer{
+		keyID: keyID,
+		key:   key,
+	}, nil
+}
+
+// KeyID returns the ID of the signing key.
+func (s *ed25519Signer) KeyID() string {
+	return s.keyID
+}
+
+// Sign signs the body.
+func (s *ed25519Signer) Sign(body []byte) []byte {
+	return ed25519.Sign(s.key, body)
+}
+
+// KeyID returns the default key ID for an ed25519 public key.
+func KeyID(key ed25519.PublicKey) string {
+	hash := sha256.Sum256(key)
+
+	return hex.EncodeToString(hash[:8])
+}
+
+// GenerateKey generates a new ed25519 private key, returning it in PEM format.
+func GenerateKey() ([]byte, ed25519.PublicKey, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate key")
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to marshal key")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), publicKey, nil
+}
+
+// ParsePrivateKey parses an ed25519 private key in PEM format.
+func ParsePrivateKey(input []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(input)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	if block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("unexpected PEM type %s", block.Type)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse private key")
+	}
+	privateKey, isPrivateKey := key.(ed25519.PrivateKey)
+	if !isPrivateKey {
+		return nil, errors.New("private key is not an ed25519 key")
+	}
+
+	return privateKey, nil
+}
+
+// ParsePublicKey parses a hex-encoded ed25519 public key.
+func ParsePublicKey(input string) (ed25519.PublicKey, error) {
+	key, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid public key")
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, errors.New("public key has incorrect length")
+	}
+
+	return key, nil
+}
@@ -0,0 +1,60 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/pkg/errors"
+)
+
+// hmacSigner signs with an HMAC-SHA256 secret.
+type hmacSigner struct {
+	keyID  string
+	secret []byte
+}
+
+// NewHMACSigner creates a signer using an HMAC-SHA256 secret.
+func NewHMACSigner(keyID string, secret []byte) (Signer, error) {
+	if keyID == "" {
+		return nil, errors.New("no key ID supplied")
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("no secret supplied")
+	}
+
+	return &hmacSigner{
+		keyID:  keyID,
+		secret: secret,
+	}, nil
+}
+
+// KeyID returns the ID of the signing key.
+func (s *hmacSigner) KeyID() string {
+	return s.keyID
+}
+
+// Sign signs the body.
+func (s *hmacSigner) Sign(body []byte) []byte {
+	return hmacSign(s.secret, body)
+}
+
+// hmacSign returns the HMAC-SHA256 of the body.
+func hmacSign(secret []byte, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,50 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing provides signing of data submitted by probec, and
+// verification of the signatures for use by collectors.
+//
+// Each submission carries the ID of the signing key in the KeyIDHeader
+// header, the time of signing in the TimestampHeader header and the
+// signature in the SignatureHeader header.  The signature is calculated
+// over the payload returned by Payload, which binds the uncompressed
+// request body to the time of signing and the request path so that a
+// captured request cannot be replayed later or to another endpoint.
+package signing
+
+import (
+	"fmt"
+)
+
+const (
+	// KeyIDHeader is the header containing the ID of the signing key.
+	KeyIDHeader = "X-Probec-Key-Id"
+	// TimestampHeader is the header containing the time of signing, in seconds since the Unix epoch.
+	TimestampHeader = "X-Probec-Timestamp"
+	// SignatureHeader is the header containing the hex-encoded signature.
+	SignatureHeader = "X-Probec-Signature"
+)
+
+// Payload returns the data that is signed for a request with the given
+// timestamp, path and uncompressed body.
+func Payload(timestamp int64, path string, body []byte) []byte {
+	return append([]byte(fmt.Sprintf("%d\n%s\n", timestamp, path)), body...)
+}
+
+// Signer signs submission bodies.
+type Signer interface {
+	// KeyID returns the ID of the signing key.
+	KeyID() string
+	// Sign signs the payload.
+	Sign(payload []byte) []byte
+}
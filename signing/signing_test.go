@@ -0,0 +1,291 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/signing"
+)
+
+func TestKeys(t *testing.T) {
+	keyPEM, publicKey, err := signing.GenerateKey()
+	require.NoError(t, err)
+
+	privateKey, err := signing.ParsePrivateKey(keyPEM)
+	require.NoError(t, err)
+	require.Equal(t, publicKey, privateKey.Public())
+
+	parsedPublicKey, err := signing.ParsePublicKey(fmt.Sprintf("%#x", []byte(publicKey)))
+	require.NoError(t, err)
+	require.Equal(t, publicKey, parsedPublicKey)
+
+	_, err = signing.ParsePrivateKey([]byte("bad"))
+	require.EqualError(t, err, "no PEM data found")
+	_, err = signing.ParsePublicKey("0x0102")
+	require.EqualError(t, err, "public key has incorrect length")
+}
+
+func TestVerify(t *testing.T) {
+	keyPEM, publicKey, err := signing.GenerateKey()
+	require.NoError(t, err)
+	privateKey, err := signing.ParsePrivateKey(keyPEM)
+	require.NoError(t, err)
+
+	ed25519Signer, err := signing.NewEd25519Signer("", privateKey)
+	require.NoError(t, err)
+	require.Equal(t, signing.KeyID(publicKey), ed25519Signer.KeyID())
+	hmacSigner, err := signing.NewHMACSigner("hmac", []byte("secret"))
+	require.NoError(t, err)
+	unknownSigner, err := signing.NewHMACSigner("unknown", []byte("secret"))
+	require.NoError(t, err)
+	wrongSigner, err := signing.NewHMACSigner("hmac", []byte("wrong"))
+	require.NoError(t, err)
+
+	verifier := signing.NewVerifier()
+	verifier.AddEd25519Key("", publicKey)
+	verifier.AddHMACSecret("hmac", []byte("secret"))
+
+	body := []byte(`{"source":"test","method":"block event","slot":"1","delay_ms":"1000"}`)
+
+	tests := []struct {
+		name   string
+		signer signing.Signer
+		body   []byte
+		err    string
+	}{
+		{
+			name:   "Ed25519",
+			signer: ed25519Signer,
+			body:   body,
+		},
+		{
+			name:   "HMAC",
+			signer: hmacSigner,
+			body:   body,
+		},
+		{
+			name:   "UnknownKey",
+			signer: unknownSigner,
+			body:   body,
+			err:    "unknown key unknown",
+		},
+		{
+			name:   "WrongSecret",
+			signer: wrongSigner,
+			body:   body,
+			err:    "invalid signature",
+		},
+		{
+			name:   "Altered",
+			signer: ed25519Signer,
+			body:   []byte(`{"source":"test","method":"block event","slot":"2","delay_ms":"1000"}`),
+			err:    "invalid signature",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := verifier.Verify(test.signer.KeyID(), test.body, test.signer.Sign(body))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	signer, err := signing.NewHMACSigner("hmac", []byte("secret"))
+	require.NoError(t, err)
+	verifier := signing.NewVerifier()
+	verifier.AddHMACSecret("hmac", []byte("secret"))
+	verifier.SetMaxBodySize(1024)
+
+	body := []byte(`[{"source":"test","method":"block event","slot":"1","delay_ms":"1000"}]`)
+	compressed := compress(t, body)
+
+	// A small compressed body that expands beyond the maximum size.
+	large := bytes.Repeat([]byte("0"), 2048)
+	compressedLarge := compress(t, large)
+	require.Less(t, len(compressedLarge), 1024)
+
+	now := time.Now().Unix()
+	timestamp := strconv.FormatInt(now, 10)
+	stale := now - int64(signing.DefaultMaxAge.Seconds()) - 60
+	signature := fmt.Sprintf("%#x", signer.Sign(signing.Payload(now, "/v1/blockdelay", body)))
+
+	tests := []struct {
+		name    string
+		body    []byte
+		headers map[string]string
+		err     string
+	}{
+		{
+			name: "KeyIDMissing",
+			body: body,
+			headers: map[string]string{
+				signing.TimestampHeader: timestamp,
+				signing.SignatureHeader: signature,
+			},
+			err: "key ID missing",
+		},
+		{
+			name: "TimestampMissing",
+			body: body,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.SignatureHeader: signature,
+			},
+			err: "timestamp missing",
+		},
+		{
+			name: "TimestampInvalid",
+			body: body,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: "bad",
+				signing.SignatureHeader: signature,
+			},
+			err: `invalid timestamp: strconv.ParseInt: parsing "bad": invalid syntax`,
+		},
+		{
+			name: "TimestampStale",
+			body: body,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: strconv.FormatInt(stale, 10),
+				signing.SignatureHeader: fmt.Sprintf("%#x", signer.Sign(signing.Payload(stale, "/v1/blockdelay", body))),
+			},
+			err: "timestamp outside of allowed window",
+		},
+		{
+			name: "SignatureMissing",
+			body: body,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: timestamp,
+			},
+			err: "signature missing",
+		},
+		{
+			name: "SignatureInvalid",
+			body: body,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: timestamp,
+				signing.SignatureHeader: "0xzz",
+			},
+			err: "invalid signature: encoding/hex: invalid byte: U+007A 'z'",
+		},
+		{
+			name: "SignatureWrongTimestamp",
+			body: body,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: strconv.FormatInt(now-1, 10),
+				signing.SignatureHeader: signature,
+			},
+			err: "invalid signature",
+		},
+		{
+			name: "SignatureWrongPath",
+			body: body,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: timestamp,
+				signing.SignatureHeader: fmt.Sprintf("%#x", signer.Sign(signing.Payload(now, "/v1/headdelay", body))),
+			},
+			err: "invalid signature",
+		},
+		{
+			name: "BodyTooLarge",
+			body: large,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: timestamp,
+				signing.SignatureHeader: fmt.Sprintf("%#x", signer.Sign(signing.Payload(now, "/v1/blockdelay", large))),
+			},
+			err: "body too large",
+		},
+		{
+			name: "DecompressedBodyTooLarge",
+			body: compressedLarge,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: timestamp,
+				signing.SignatureHeader: fmt.Sprintf("%#x", signer.Sign(signing.Payload(now, "/v1/blockdelay", large))),
+				"Content-Encoding":      "gzip",
+			},
+			err: "decompressed body too large",
+		},
+		{
+			name: "Good",
+			body: body,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: timestamp,
+				signing.SignatureHeader: signature,
+			},
+		},
+		{
+			name: "GoodCompressed",
+			body: compressed,
+			headers: map[string]string{
+				signing.KeyIDHeader:     "hmac",
+				signing.TimestampHeader: timestamp,
+				signing.SignatureHeader: signature,
+				"Content-Encoding":      "gzip",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/blockdelay", bytes.NewReader(test.body))
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+			keyID, verifiedBody, err := verifier.VerifyRequest(httptest.NewRecorder(), req)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "hmac", keyID)
+				require.Equal(t, body, verifiedBody)
+			}
+		})
+	}
+}
+
+func compress(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, err := writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return compressed.Bytes()
+}
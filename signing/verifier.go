@@ -0,0 +1,173 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultMaxBodySize is the default maximum size of a request body, both
+// before and after decompression.
+const DefaultMaxBodySize = 16 * 1024 * 1024
+
+// DefaultMaxAge is the default maximum difference between the timestamp of
+// a request and the time at which it is verified.
+const DefaultMaxAge = 5 * time.Minute
+
+// Verifier verifies signed submissions against a set of known keys.
+type Verifier struct {
+	mu          sync.RWMutex
+	keys        map[string]func(body []byte, signature []byte) bool
+	maxBodySize int64
+	maxAge      time.Duration
+}
+
+// NewVerifier creates a verifier with no known keys.
+func NewVerifier() *Verifier {
+	return &Verifier{
+		keys:        make(map[string]func(body []byte, signature []byte) bool),
+		maxBodySize: DefaultMaxBodySize,
+		maxAge:      DefaultMaxAge,
+	}
+}
+
+// SetMaxBodySize sets the maximum size of a request body, both before and after decompression.
+func (v *Verifier) SetMaxBodySize(size int64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.maxBodySize = size
+}
+
+// SetMaxAge sets the maximum difference between the timestamp of a request
+// and the time at which it is verified.
+func (v *Verifier) SetMaxAge(age time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.maxAge = age
+}
+
+// AddEd25519Key adds an ed25519 public key to the verifier.
+// If the key ID is empty it is derived from the public key.
+func (v *Verifier) AddEd25519Key(keyID string, key ed25519.PublicKey) {
+	if keyID == "" {
+		keyID = KeyID(key)
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys[keyID] = func(body []byte, signature []byte) bool {
+		return ed25519.Verify(key, body, signature)
+	}
+}
+
+// AddHMACSecret adds an HMAC-SHA256 secret to the verifier.
+func (v *Verifier) AddHMACSecret(keyID string, secret []byte) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys[keyID] = func(body []byte, signature []byte) bool {
+		return hmac.Equal(hmacSign(secret, body), signature)
+	}
+}
+
+// Verify verifies the signature of a payload with the given key.
+func (v *Verifier) Verify(keyID string, body []byte, signature []byte) error {
+	v.mu.RLock()
+	verify, exists := v.keys[keyID]
+	v.mu.RUnlock()
+	if !exists {
+		return fmt.Errorf("unknown key %s", keyID)
+	}
+	if !verify(body, signature) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// VerifyRequest reads the body of a submission request, decompressing it
+// if required, and verifies its timestamp and signature.  It returns the
+// ID of the key that signed the request and the uncompressed body.
+func (v *Verifier) VerifyRequest(w http.ResponseWriter, r *http.Request) (string, []byte, error) {
+	v.mu.RLock()
+	maxBodySize := v.maxBodySize
+	maxAge := v.maxAge
+	v.mu.RUnlock()
+
+	keyID := r.Header.Get(KeyIDHeader)
+	if keyID == "" {
+		return "", nil, errors.New("key ID missing")
+	}
+	timestampStr := r.Header.Get(TimestampHeader)
+	if timestampStr == "" {
+		return "", nil, errors.New("timestamp missing")
+	}
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "invalid timestamp")
+	}
+	if age := time.Since(time.Unix(timestamp, 0)); age > maxAge || age < -maxAge {
+		return "", nil, errors.New("timestamp outside of allowed window")
+	}
+	signatureHex := r.Header.Get(SignatureHeader)
+	if signatureHex == "" {
+		return "", nil, errors.New("signature missing")
+	}
+	signature, err := hex.DecodeString(strings.TrimPrefix(signatureHex, "0x"))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "invalid signature")
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return "", nil, errors.New("body too large")
+		}
+
+		return "", nil, errors.Wrap(err, "failed to read body")
+	}
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to decompress body")
+		}
+		// Read one byte more than permitted to detect overflow.
+		body, err = io.ReadAll(io.LimitReader(reader, maxBodySize+1))
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to decompress body")
+		}
+		if int64(len(body)) > maxBodySize {
+			return "", nil, errors.New("decompressed body too large")
+		}
+	}
+
+	if err := v.Verify(keyID, Payload(timestamp, r.URL.Path, body), signature); err != nil {
+		return "", nil, err
+	}
+
+	return keyID, body, nil
+}
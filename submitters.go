@@ -0,0 +1,161 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/wealdtech/probec/services/chaintime"
+	"github.com/wealdtech/probec/services/metrics"
+	"github.com/wealdtech/probec/services/submitter"
+	consolesubmitter "github.com/wealdtech/probec/services/submitter/console"
+	fanoutsubmitter "github.com/wealdtech/probec/services/submitter/fanout"
+	filesubmitter "github.com/wealdtech/probec/services/submitter/file"
+	immediatesubmitter "github.com/wealdtech/probec/services/submitter/immediate"
+	"github.com/wealdtech/probec/util"
+)
+
+// submitterKey returns the configuration key for the submitter at the path.
+// Submitters within a fanout submitter inherit any configuration that they
+// do not set themselves from the top-level submitter configuration.
+func submitterKey(path string, key string) string {
+	fullKey := fmt.Sprintf("%s.%s", path, key)
+	if path != "submitter" && !viper.IsSet(fullKey) {
+		return fmt.Sprintf("submitter.%s", key)
+	}
+
+	return fullKey
+}
+
+// startSubmitter starts the submitter configured at the path.
+func startSubmitter(ctx context.Context,
+	monitor metrics.Service,
+	chainTime chaintime.Service,
+	path string,
+) (
+	submitter.Service,
+	error,
+) {
+	style := viper.GetString(fmt.Sprintf("%s.style", path))
+	logPath := fmt.Sprintf("%s.%s", path, style)
+
+	switch style {
+	case "immediate":
+		baseUrls, collectorConfigs, err := obtainCollectors(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(baseUrls) == 0 {
+			return nil, errors.New("no submitter base URL supplied")
+		}
+		signer, err := obtainSigner()
+		if err != nil {
+			return nil, err
+		}
+
+		queueDir := ""
+		if viper.GetBool(submitterKey(path, "queue.enable")) {
+			queueDir = util.ResolvePath(viper.GetString(submitterKey(path, "queue.dir")))
+		}
+
+		return immediatesubmitter.New(ctx,
+			immediatesubmitter.WithLogLevel(util.LogLevel(logPath)),
+			immediatesubmitter.WithMonitor(monitor),
+			immediatesubmitter.WithBaseURLs(baseUrls),
+			immediatesubmitter.WithCollectorConfigs(collectorConfigs),
+			immediatesubmitter.WithTimeout(util.Timeout(path)),
+			immediatesubmitter.WithQueueDir(queueDir),
+			immediatesubmitter.WithQueueMaxEntries(viper.GetInt(submitterKey(path, "queue.max-entries"))),
+			immediatesubmitter.WithQueueMaxAge(viper.GetDuration(submitterKey(path, "queue.max-age"))),
+			immediatesubmitter.WithQueueInitialBackoff(viper.GetDuration(submitterKey(path, "queue.initial-backoff"))),
+			immediatesubmitter.WithQueueMaxBackoff(viper.GetDuration(submitterKey(path, "queue.max-backoff"))),
+			immediatesubmitter.WithBatch(viper.GetBool(submitterKey(path, "batch.enable"))),
+			immediatesubmitter.WithBatchMaxItems(viper.GetInt(submitterKey(path, "batch.max-items"))),
+			immediatesubmitter.WithBatchInterval(viper.GetDuration(submitterKey(path, "batch.interval"))),
+			immediatesubmitter.WithChainTime(chainTime),
+			immediatesubmitter.WithCompress(viper.GetBool(submitterKey(path, "compress"))),
+			immediatesubmitter.WithSigner(signer),
+		)
+	case "file":
+		return filesubmitter.New(ctx,
+			filesubmitter.WithLogLevel(util.LogLevel(logPath)),
+			filesubmitter.WithMonitor(monitor),
+			filesubmitter.WithDir(util.ResolvePath(viper.GetString(submitterKey(path, "file.dir")))),
+			filesubmitter.WithMaxSize(viper.GetInt64(submitterKey(path, "file.max-size"))),
+			filesubmitter.WithMaxAge(viper.GetDuration(submitterKey(path, "file.max-age"))),
+			filesubmitter.WithCompress(viper.GetBool(submitterKey(path, "file.compress"))),
+			filesubmitter.WithSyncPolicy(filesubmitter.SyncPolicy(viper.GetString(submitterKey(path, "file.sync-policy")))),
+			filesubmitter.WithSyncInterval(viper.GetDuration(submitterKey(path, "file.sync-interval"))),
+		)
+	case "console":
+		return consolesubmitter.New(ctx,
+			consolesubmitter.WithLogLevel(util.LogLevel(logPath)),
+			consolesubmitter.WithMonitor(monitor),
+		)
+	case "fanout":
+		if path != "submitter" {
+			return nil, errors.New("fanout submitters cannot be nested")
+		}
+
+		return startFanoutSubmitter(ctx, monitor, chainTime, path)
+	case "":
+		return nil, fmt.Errorf("no style supplied for %s", path)
+	default:
+		return nil, fmt.Errorf("unknown submitter %s", style)
+	}
+}
+
+// startFanoutSubmitter starts a fanout submitter and each of its child submitters.
+func startFanoutSubmitter(ctx context.Context,
+	monitor metrics.Service,
+	chainTime chaintime.Service,
+	path string,
+) (
+	submitter.Service,
+	error,
+) {
+	childrenPath := fmt.Sprintf("%s.submitters", path)
+	names := make([]string, 0)
+	for name := range viper.GetStringMap(childrenPath) {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no submitters supplied for fanout")
+	}
+	sort.Strings(names)
+
+	submitters := make([]*fanoutsubmitter.Submitter, 0, len(names))
+	for _, name := range names {
+		childPath := fmt.Sprintf("%s.%s", childrenPath, name)
+		service, err := startSubmitter(ctx, monitor, chainTime, childPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to start submitter %s", name)
+		}
+		submitters = append(submitters, &fanoutsubmitter.Submitter{
+			Name:    name,
+			Service: service,
+			Types:   viper.GetStringSlice(fmt.Sprintf("%s.types", childPath)),
+		})
+	}
+
+	return fanoutsubmitter.New(ctx,
+		fanoutsubmitter.WithLogLevel(util.LogLevel(fmt.Sprintf("%s.fanout", path))),
+		fanoutsubmitter.WithMonitor(monitor),
+		fanoutsubmitter.WithSubmitters(submitters),
+	)
+}
@@ -0,0 +1,48 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ResolveSecret resolves a secret from its configuration value.
+// A value of the form "file:<path>" is read from the file at the path,
+// and a value of the form "env:<name>" is read from the environment
+// variable with the name.  Any other value is returned as-is.
+func ResolveSecret(input string) (string, error) {
+	switch {
+	case strings.HasPrefix(input, "file:"):
+		data, err := os.ReadFile(ResolvePath(strings.TrimPrefix(input, "file:")))
+		if err != nil {
+			return "", errors.Wrap(err, "failed to read secret file")
+		}
+
+		return strings.TrimSpace(string(data)), nil
+	case strings.HasPrefix(input, "env:"):
+		name := strings.TrimPrefix(input, "env:")
+		value, exists := os.LookupEnv(name)
+		if !exists {
+			return "", fmt.Errorf("environment variable %s not set", name)
+		}
+
+		return value, nil
+	default:
+		return input, nil
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright © 2026 Weald Technology Trading.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wealdtech/probec/util"
+)
+
+func TestResolveSecret(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("file secret\n"), 0o600))
+	t.Setenv("PROBEC_TEST_SECRET", "env secret")
+
+	tests := []struct {
+		name   string
+		input  string
+		secret string
+		err    string
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name:   "Literal",
+			input:  "literal secret",
+			secret: "literal secret",
+		},
+		{
+			name:   "File",
+			input:  "file:" + secretFile,
+			secret: "file secret",
+		},
+		{
+			name:  "FileMissing",
+			input: "file:" + filepath.Join(dir, "missing"),
+			err:   "failed to read secret file: open " + filepath.Join(dir, "missing") + ": no such file or directory",
+		},
+		{
+			name:   "Env",
+			input:  "env:PROBEC_TEST_SECRET",
+			secret: "env secret",
+		},
+		{
+			name:  "EnvMissing",
+			input: "env:PROBEC_TEST_MISSING",
+			err:   "environment variable PROBEC_TEST_MISSING not set",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			secret, err := util.ResolveSecret(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.secret, secret)
+			}
+		})
+	}
+}